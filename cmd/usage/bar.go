@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"time"
+
+	"claude-monitor/internal/app"
+	"claude-monitor/internal/consts"
+)
+
+// barCommand streams status bar lines (Waybar, i3blocks, i3bar, polybar) to
+// stdout, one per poll, until interrupted.
+func barCommand(fs *flag.FlagSet) runFunc {
+	flags := registerConfigFlags(fs)
+	format := fs.String(consts.FlagFormatName, consts.FormatWaybar, consts.FlagBarFormatHelp)
+	maxAge := fs.Duration(consts.FlagMaxAgeName, 10*time.Minute, consts.FlagMaxAgeHelp)
+	remaining := fs.Bool(consts.FlagRemainingName, false, consts.FlagRemainingHelp)
+	style := registerStyleFlags(fs)
+	resetTime := registerResetTimeFlags(fs)
+
+	return func(ctx context.Context) (int, error) {
+		if err := resetTime(); err != nil {
+			return 1, err
+		}
+		if err := style.apply(); err != nil {
+			return 1, err
+		}
+		cfg, err := flags.config()
+		if err != nil {
+			return 1, err
+		}
+		if cfg.Bands, err = style.severity(cfg.Threshold); err != nil {
+			return 1, err
+		}
+		return exitCode(app.RunStatusBar(ctx, cfg, *format, os.Stdout, app.StatusBarOptions{
+			MaxAge:    *maxAge,
+			Remaining: *remaining,
+		}))
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"claude-monitor/internal/app"
+	"claude-monitor/internal/consts"
+)
+
+// Default check thresholds, in utilization percent.
+const (
+	defaultCheckWarn = 80.0
+	defaultCheckCrit = 95.0
+)
+
+// checkCommand fetches usage once, prints a Nagios-style summary with
+// perfdata, and returns the plugin exit code. Every failure, including bad
+// flags and token errors, is reported as UNKNOWN so monitoring never sees
+// exit 1 for a broken probe.
+func checkCommand(fs *flag.FlagSet) runFunc {
+	flags := registerConfigFlags(fs)
+	warn := fs.String(consts.FlagWarnName, "", consts.FlagWarnHelp)
+	crit := fs.String(consts.FlagCritName, "", consts.FlagCritHelp)
+
+	return func(ctx context.Context) (int, error) {
+		res := check(ctx, flags, *warn, *crit)
+		fmt.Fprintln(os.Stdout, res.Line)
+		return res.Code, nil
+	}
+}
+
+// checkFlagError reports a check flag error as UNKNOWN. The flag package has
+// already printed the error and usage to stderr; a plugin line on stdout
+// keeps the reason visible to the monitoring system, and -h exits UNKNOWN as
+// well since no check was run.
+//
+// Returns:
+//   - app.CheckUnknown.
+func checkFlagError(err error) int {
+	if !errors.Is(err, flag.ErrHelp) {
+		fmt.Fprintln(os.Stdout, app.CheckUnknownResult(err.Error()).Line)
+	}
+	return app.CheckUnknown
+}
+
+// check parses thresholds and configuration, then runs the check.
+func check(ctx context.Context, flags *configFlags, warn, crit string) app.CheckResult {
+	var th app.CheckThresholds
+	var err error
+	if th.Warn, err = app.ParseCheckThreshold(warn, defaultCheckWarn); err != nil {
+		return app.CheckUnknownResult(err.Error())
+	}
+	if th.Crit, err = app.ParseCheckThreshold(crit, defaultCheckCrit); err != nil {
+		return app.CheckUnknownResult(err.Error())
+	}
+	if err := th.Validate(); err != nil {
+		return app.CheckUnknownResult(err.Error())
+	}
+	cfg, err := flags.config()
+	if err != nil {
+		return app.CheckUnknownResult(err.Error())
+	}
+	return app.Check(ctx, cfg, th)
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/i18n"
+)
+
+// runFunc executes a command after its flags are parsed.
+//
+// Returns:
+//   - process exit code.
+//   - error to print; a nil error with a non-zero code exits silently.
+type runFunc func(ctx context.Context) (int, error)
+
+// command describes one subcommand: its name, help text, and a setup function
+// that registers flags on a fresh FlagSet and returns the runner. Setting up
+// flags separately from running lets help and completion scripts list every
+// command's flags without executing it.
+type command struct {
+	name    string
+	args    string
+	summary string
+	setup   func(fs *flag.FlagSet) runFunc
+	// flagError, when set, handles flag parse errors (including -h) instead
+	// of the default exit codes 2 and 0, and returns the exit code.
+	flagError func(err error) int
+}
+
+// commands lists every subcommand in help order.
+func commands() []command {
+	return []command{
+		{name: consts.CmdTUI, summary: consts.SummaryTUI, setup: tuiCommand},
+		{name: consts.CmdStatus, summary: consts.SummaryStatus, setup: statusCommand},
+		{name: consts.CmdSnapshot, summary: consts.SummarySnapshot, setup: snapshotCommand},
+		{name: consts.CmdWatch, summary: consts.SummaryWatch, setup: watchCommand},
+		{name: consts.CmdServe, summary: consts.SummaryServe, setup: serveCommand},
+		{name: consts.CmdReport, summary: consts.SummaryReport, setup: reportCommand},
+		{name: consts.CmdExport, summary: consts.SummaryExport, setup: exportCommand},
+		{name: consts.CmdCheck, summary: consts.SummaryCheck, setup: checkCommand, flagError: checkFlagError},
+		{name: consts.CmdWait, args: consts.ArgsWait, summary: consts.SummaryWait, setup: waitCommand},
+		{name: consts.CmdTmux, summary: consts.SummaryTmux, setup: tmuxCommand},
+		{name: consts.CmdBar, summary: consts.SummaryBar, setup: barCommand},
+		{name: consts.CmdDoctor, summary: consts.SummaryDoctor, setup: doctorCommand},
+		{name: consts.CmdDemo, summary: consts.SummaryDemo, setup: demoCommand},
+		{name: consts.CmdConfig, summary: consts.SummaryConfig, setup: configCommand},
+		{name: consts.CmdVersion, summary: consts.SummaryVersion, setup: versionCommand},
+		{name: consts.CmdCompletion, args: consts.ArgsCompletion, summary: consts.SummaryCompletion, setup: completionCommand},
+		{name: consts.CmdHelp, args: consts.ArgsHelp, summary: consts.SummaryHelp, setup: helpCommand},
+	}
+}
+
+// lookupCommand finds a command by name.
+func lookupCommand(name string) (command, bool) {
+	for _, c := range commands() {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return command{}, false
+}
+
+// newFlagSet builds the FlagSet for c with per-command help output. Every
+// command accepts -lang; dispatch applies it before flags are registered so
+// the help text is already translated.
+func newFlagSet(c command) *flag.FlagSet {
+	handling := flag.ExitOnError
+	if c.flagError != nil {
+		handling = flag.ContinueOnError
+	}
+	fs := flag.NewFlagSet(c.name, handling)
+	fs.Usage = func() { printCommandUsage(fs.Output(), c, fs) }
+	fs.String(consts.FlagLangName, "", consts.FlagLangHelp)
+	return fs
+}
+
+// dispatch picks the command named by args[0] (tui when args is empty or
+// starts with a flag), parses its flags, and runs it.
+//
+// Parameters:
+//   - ctx: canceled on SIGINT/SIGTERM.
+//   - args: command line without the program name.
+//
+// Returns:
+//   - process exit code.
+func dispatch(ctx context.Context, args []string) int {
+	lang, err := i18n.Parse(langArg(args))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, consts.TextErrorFmt+"\n", err)
+		return 2
+	}
+	i18n.Apply(lang)
+
+	name := consts.CmdTUI
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	} else if len(args) > 0 && isHelpFlag(args[0]) {
+		printUsage(os.Stdout)
+		return 0
+	}
+
+	c, ok := lookupCommand(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, consts.TextErrorFmt+"\n", fmt.Errorf(consts.ErrUnknownCommandFmt, name))
+		return 2
+	}
+	fs := newFlagSet(c)
+	run := c.setup(fs)
+	if err := fs.Parse(args); err != nil {
+		return c.flagError(err)
+	}
+
+	code, err := run(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, consts.TextErrorFmt+"\n", err)
+		if code == 0 {
+			code = 1
+		}
+	}
+	return code
+}
+
+// langArg finds the -lang value in args ahead of flag parsing, stopping at
+// "--" so a wrapped command's own flags are left alone.
+//
+// Returns:
+//   - the last value given, or empty when the flag is absent.
+func langArg(args []string) string {
+	var value string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			break
+		}
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+		name, v, ok := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if name != consts.FlagLangName {
+			continue
+		}
+		if ok {
+			value = v
+		} else if i+1 < len(args) {
+			i++
+			value = args[i]
+		}
+	}
+	return value
+}
+
+// isHelpFlag reports whether arg asks for top-level help.
+func isHelpFlag(arg string) bool {
+	switch arg {
+	case "-h", "-help", "--help":
+		return true
+	}
+	return false
+}
+
+// exitCode maps an error-only result to a runFunc result.
+func exitCode(err error) (int, error) {
+	if err != nil {
+		return 1, err
+	}
+	return 0, nil
+}
+
+// printUsage writes top-level help: the command list followed by the tui
+// flags, since those apply when no command is given.
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, consts.TextUsageFmt+"\n\n%s\n", consts.ProgramName, consts.TextCommandsTitle)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, c := range commands() {
+		fmt.Fprintf(tw, "  %s\t%s\n", c.name, c.summary)
+	}
+	tw.Flush()
+
+	tui, _ := lookupCommand(consts.CmdTUI)
+	fs := newFlagSet(tui)
+	tui.setup(fs)
+	fs.SetOutput(w)
+	fmt.Fprintf(w, "\n%s\n", consts.TextFlagsTitle)
+	fs.PrintDefaults()
+	fmt.Fprintf(w, "\n"+consts.TextHelpFooterFmt+"\n", consts.ProgramName)
+}
+
+// printCommandUsage writes help for a single command.
+func printCommandUsage(w io.Writer, c command, fs *flag.FlagSet) {
+	synopsis := fmt.Sprintf(consts.TextCommandUsageFmt, consts.ProgramName, c.name, c.args)
+	fmt.Fprintf(w, "%s\n\n%s\n", strings.TrimSpace(synopsis), c.summary)
+	hasFlags := false
+	fs.VisitAll(func(*flag.Flag) { hasFlags = true })
+	if hasFlags {
+		fmt.Fprintf(w, "\n%s\n", consts.TextFlagsTitle)
+		fs.PrintDefaults()
+	}
+}
+
+// helpCommand prints top-level help, or a command's help when named.
+func helpCommand(fs *flag.FlagSet) runFunc {
+	return func(ctx context.Context) (int, error) {
+		if fs.NArg() == 0 {
+			printUsage(os.Stdout)
+			return 0, nil
+		}
+		c, ok := lookupCommand(fs.Arg(0))
+		if !ok {
+			return 2, fmt.Errorf(consts.ErrUnknownCommandFmt, fs.Arg(0))
+		}
+		cfs := newFlagSet(c)
+		c.setup(cfs)
+		cfs.SetOutput(os.Stdout)
+		printCommandUsage(os.Stdout, c, cfs)
+		return 0, nil
+	}
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"claude-monitor/internal/consts"
+)
+
+// completionShells lists the shells completion scripts are generated for.
+var completionShells = []string{"bash", "zsh", "fish"}
+
+// completionCommand prints a completion script for the named shell. Scripts
+// are generated from the command table, so new commands and flags are picked
+// up automatically.
+func completionCommand(fs *flag.FlagSet) runFunc {
+	return func(context.Context) (int, error) {
+		if fs.NArg() == 0 {
+			return 2, errors.New(consts.ErrShellRequired)
+		}
+		switch shell := fs.Arg(0); shell {
+		case "bash":
+			writeBashCompletion(os.Stdout)
+		case "zsh":
+			writeZshCompletion(os.Stdout)
+		case "fish":
+			writeFishCompletion(os.Stdout)
+		default:
+			return 2, fmt.Errorf(consts.ErrShellFmt, shell)
+		}
+		return 0, nil
+	}
+}
+
+// completionFlag is one flag as completion scripts need it.
+type completionFlag struct {
+	name  string
+	usage string
+	bool  bool
+}
+
+// commandFlags registers c's flags on a scratch FlagSet and lists them.
+func commandFlags(c command) []completionFlag {
+	fs := newFlagSet(c)
+	c.setup(fs)
+	var out []completionFlag
+	fs.VisitAll(func(f *flag.Flag) {
+		bf, ok := f.Value.(interface{ IsBoolFlag() bool })
+		out = append(out, completionFlag{name: f.Name, usage: f.Usage, bool: ok && bf.IsBoolFlag()})
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].name < out[j].name })
+	return out
+}
+
+// commandArgs returns fixed positional completions for c.
+func commandArgs(c command) []string {
+	switch c.name {
+	case consts.CmdCompletion:
+		return completionShells
+	case consts.CmdHelp:
+		names := make([]string, 0, len(commands()))
+		for _, c := range commands() {
+			names = append(names, c.name)
+		}
+		return names
+	}
+	return nil
+}
+
+// funcName is the shell function name used by bash and zsh scripts.
+var funcName = "_" + strings.ReplaceAll(consts.ProgramName, "-", "_")
+
+// writeBashCompletion writes a bash script completing commands, flags, and
+// fixed arguments; flag values fall back to file names.
+func writeBashCompletion(w io.Writer) {
+	var names []string
+	for _, c := range commands() {
+		names = append(names, c.name)
+	}
+	tui, _ := lookupCommand(consts.CmdTUI)
+
+	fmt.Fprintf(w, "# bash completion for %s\n", consts.ProgramName)
+	fmt.Fprintf(w, "%s() {\n", funcName)
+	fmt.Fprintln(w, `    local cur="${COMP_WORDS[COMP_CWORD]}" cmd="${COMP_WORDS[1]}" words=""`)
+	fmt.Fprintln(w, `    if [[ $COMP_CWORD -eq 1 ]]; then`)
+	fmt.Fprintf(w, "        if [[ $cur == -* ]]; then words=%q; else words=%q; fi\n", bashFlags(tui), strings.Join(names, " "))
+	fmt.Fprintln(w, `        COMPREPLY=( $(compgen -W "$words" -- "$cur") )`)
+	fmt.Fprintln(w, `        return`)
+	fmt.Fprintln(w, `    fi`)
+	fmt.Fprintln(w, `    case "$cmd" in`)
+	for _, c := range commands() {
+		words := strings.TrimSpace(bashFlags(c) + " " + strings.Join(commandArgs(c), " "))
+		fmt.Fprintf(w, "        %s) words=%q ;;\n", c.name, words)
+	}
+	fmt.Fprintf(w, "        -*) words=%q ;;\n", bashFlags(tui))
+	fmt.Fprintln(w, `    esac`)
+	fmt.Fprintln(w, `    COMPREPLY=( $(compgen -W "$words" -- "$cur") )`)
+	fmt.Fprintln(w, `}`)
+	fmt.Fprintf(w, "complete -o default -F %s %s\n", funcName, consts.ProgramName)
+}
+
+// bashFlags lists c's flags as "-name" words.
+func bashFlags(c command) string {
+	var words []string
+	for _, f := range commandFlags(c) {
+		words = append(words, "-"+f.name)
+	}
+	return strings.Join(words, " ")
+}
+
+// writeZshCompletion writes a zsh script with command descriptions and
+// per-command flag help.
+func writeZshCompletion(w io.Writer) {
+	fmt.Fprintf(w, "#compdef %s\n\n", consts.ProgramName)
+	fmt.Fprintf(w, "%s() {\n", funcName)
+	fmt.Fprintln(w, `    local -a commands`)
+	fmt.Fprintln(w, `    commands=(`)
+	for _, c := range commands() {
+		fmt.Fprintf(w, "        %s\n", zshQuote(c.name+":"+c.summary))
+	}
+	fmt.Fprintln(w, `    )`)
+	fmt.Fprintln(w, `    if (( CURRENT == 2 )) && [[ $words[2] != -* ]]; then`)
+	fmt.Fprintln(w, `        _describe 'command' commands`)
+	fmt.Fprintln(w, `        return`)
+	fmt.Fprintln(w, `    fi`)
+	fmt.Fprintln(w, `    local cmd=$words[2]`)
+	fmt.Fprintln(w, `    if [[ $cmd == -* ]]; then`)
+	fmt.Fprintf(w, "        cmd=%s\n", consts.CmdTUI)
+	fmt.Fprintln(w, `    else`)
+	fmt.Fprintln(w, `        shift words; (( CURRENT-- ))`)
+	fmt.Fprintln(w, `    fi`)
+	fmt.Fprintln(w, `    case $cmd in`)
+	for _, c := range commands() {
+		specs := []string{}
+		for _, f := range commandFlags(c) {
+			spec := "-" + f.name + "[" + zshEscape(f.usage) + "]"
+			if !f.bool {
+				spec += ":" + f.name + ":_files"
+			}
+			specs = append(specs, zshQuote(spec))
+		}
+		if args := commandArgs(c); len(args) > 0 {
+			specs = append(specs, zshQuote("1:argument:("+strings.Join(args, " ")+")"))
+		} else if c.args != "" {
+			specs = append(specs, zshQuote("*::argument:_normal"))
+		}
+		fmt.Fprintf(w, "        %s) _arguments %s ;;\n", c.name, strings.Join(specs, " "))
+	}
+	fmt.Fprintln(w, `    esac`)
+	fmt.Fprintln(w, `}`)
+	fmt.Fprintf(w, "\ncompdef %s %s\n", funcName, consts.ProgramName)
+}
+
+// zshEscape escapes characters special inside an _arguments description.
+func zshEscape(s string) string {
+	return strings.NewReplacer("[", `\[`, "]", `\]`, ":", `\:`).Replace(s)
+}
+
+// zshQuote single-quotes s for zsh.
+func zshQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// writeFishCompletion writes fish completions; Go's single-dash long flags
+// map to fish's old-style (-o) options.
+func writeFishCompletion(w io.Writer) {
+	prog := consts.ProgramName
+	fmt.Fprintf(w, "# fish completion for %s\n", prog)
+	fmt.Fprintf(w, "complete -c %s -f\n", prog)
+	for _, c := range commands() {
+		fmt.Fprintf(w, "complete -c %s -n __fish_use_subcommand -a %s -d %s\n", prog, c.name, fishQuote(c.summary))
+	}
+	for _, c := range commands() {
+		cond := "__fish_seen_subcommand_from " + c.name
+		if c.name == consts.CmdTUI {
+			cond = "__fish_use_subcommand; or " + cond
+		}
+		for _, f := range commandFlags(c) {
+			req := " -r"
+			if f.bool {
+				req = ""
+			}
+			fmt.Fprintf(w, "complete -c %s -n %s -o %s%s -d %s\n", prog, fishQuote(cond), f.name, req, fishQuote(f.usage))
+		}
+		if args := commandArgs(c); len(args) > 0 {
+			fmt.Fprintf(w, "complete -c %s -n %s -a %s\n", prog, fishQuote(cond), fishQuote(strings.Join(args, " ")))
+		}
+	}
+}
+
+// fishQuote single-quotes s for fish.
+func fishQuote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(s) + "'"
+}
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"claude-monitor/internal/app"
+	"claude-monitor/internal/auth"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
+	"claude-monitor/internal/theme"
+	"claude-monitor/internal/utils"
+)
+
+// defaultHTTPTimeout is used when no -http-timeout flag or ANTHROPIC_HTTP_TIMEOUT
+// environment variable is provided.
+const defaultHTTPTimeout = 8 * time.Second
+
+// configFlags holds the flags shared by every mode that talks to the API.
+type configFlags struct {
+	fs             *flag.FlagSet
+	refresh        *time.Duration
+	minRefresh     *time.Duration
+	maxRefresh     *time.Duration
+	threshold      *float64
+	credPath       *string
+	httpTimeout    *time.Duration
+	timeoutWarning string
+	betaHeader     *string
+	historyPath    *string
+	cachePath      *string
+	betaStatePath  *string
+}
+
+// registerConfigFlags defines the API, polling, and persistence flags on fs.
+func registerConfigFlags(fs *flag.FlagSet) *configFlags {
+	f := &configFlags{fs: fs}
+	f.refresh = fs.Duration(consts.FlagIntervalName, 30*time.Second, consts.FlagIntervalHelp)
+	f.minRefresh = fs.Duration(consts.FlagMinIntervalName, 0, consts.FlagMinIntervalHelp)
+	f.maxRefresh = fs.Duration(consts.FlagMaxIntervalName, 0, consts.FlagMaxIntervalHelp)
+	f.threshold = fs.Float64(consts.FlagThresholdName, 80, consts.FlagThresholdHelp)
+	f.credPath = fs.String(consts.FlagCredsName, auth.DefaultCredPath(), consts.FlagCredsHelp)
+
+	timeoutDefault, timeoutWarning := loadTimeoutDefault()
+	f.timeoutWarning = timeoutWarning
+	f.httpTimeout = fs.Duration(consts.FlagTimeoutName, timeoutDefault, consts.FlagTimeoutHelp)
+	f.betaHeader = fs.String(consts.FlagBetaName, loadBetaDefault(), consts.FlagBetaHelp)
+	f.historyPath = fs.String(consts.FlagHistoryName, store.DefaultSamplesPath(), consts.FlagHistoryHelp)
+	f.cachePath = fs.String(consts.FlagCacheName, store.DefaultSnapshotPath(), consts.FlagCacheHelp)
+	f.betaStatePath = fs.String(consts.FlagBetaStateName, store.DefaultBetaPath(), consts.FlagBetaStateHelp)
+	return f
+}
+
+// config prints configuration warnings, resolves the OAuth token, and builds
+// a validated Config from the parsed flags.
+//
+// Returns:
+//   - validated Config.
+//   - error wrapped with the token or config error prefix.
+func (f *configFlags) config() (app.Config, error) {
+	if f.timeoutWarning != "" {
+		fmt.Fprintln(os.Stderr, f.timeoutWarning)
+	}
+	betas := splitList(*f.betaHeader)
+	if len(betas) > 0 && betas[0] == consts.DefaultBetaName {
+		fmt.Fprintln(os.Stderr, consts.TextBetaDefaultWarning)
+	}
+	token, err := auth.ResolveToken(*f.credPath)
+	if err != nil {
+		return app.Config{}, fmt.Errorf(consts.TextTokenErrorFmt, err)
+	}
+
+	cfg := app.Config{
+		Token:        token,
+		Credentials:  *f.credPath,
+		RefreshEvery: *f.refresh,
+		MinRefresh:   *f.minRefresh,
+		MaxRefresh:   *f.maxRefresh,
+		Threshold:    *f.threshold,
+		HTTPClient:   newHTTPClient(*f.httpTimeout),
+		Samples:      openSampleLog(*f.historyPath),
+		Snapshot:     openSnapshot(*f.cachePath),
+		BetaState:    openBetaState(*f.betaStatePath),
+	}
+	if len(betas) > 0 {
+		cfg.BetaHeader = betas[0]
+		cfg.BetaCandidates = betas[1:]
+		cfg.BetaExplicit = f.betaExplicit()
+	}
+	if err := cfg.Validate(); err != nil {
+		return app.Config{}, fmt.Errorf(consts.TextConfigErrFmt, err)
+	}
+	return cfg, nil
+}
+
+// styleFlags holds the theme and severity flags of every mode that renders
+// colors.
+type styleFlags struct {
+	theme    *string
+	themeDir *string
+	bands    *string
+	gradient *bool
+}
+
+// registerStyleFlags defines -theme, -theme-dir, -bands and -gradient on fs.
+// The theme defaults to CLAUDE_MONITOR_THEME, then to high-contrast when
+// CLAUDE_MONITOR_HIGH_CONTRAST is set.
+func registerStyleFlags(fs *flag.FlagSet) *styleFlags {
+	return &styleFlags{
+		theme:    fs.String(consts.FlagThemeName, loadThemeDefault(), consts.FlagThemeHelp),
+		themeDir: fs.String(consts.FlagThemeDirName, theme.DefaultDir(), consts.FlagThemeDirHelp),
+		bands:    fs.String(consts.FlagBandsName, "", consts.FlagBandsHelp),
+		gradient: fs.Bool(consts.FlagGradientName, false, consts.FlagGradientHelp),
+	}
+}
+
+// themes returns the theme cycle starting with the selected theme.
+func (f *styleFlags) themes() ([]theme.Theme, error) {
+	return theme.Select(*f.theme, *f.themeDir)
+}
+
+// apply selects the theme for one-shot output.
+func (f *styleFlags) apply() error {
+	themes, err := f.themes()
+	if err != nil {
+		return err
+	}
+	app.ApplyTheme(themes[0])
+	return nil
+}
+
+// severity parses the bands, starting crit at threshold by default.
+func (f *styleFlags) severity(threshold float64) (app.Bands, error) {
+	bands, err := app.ParseBands(*f.bands, threshold)
+	if err != nil {
+		return app.Bands{}, err
+	}
+	bands.Gradient = *f.gradient
+	return bands, nil
+}
+
+// registerKeysFlag defines -keys on fs and returns a loader for the key map.
+func registerKeysFlag(fs *flag.FlagSet) func() (*app.KeyMap, error) {
+	path := fs.String(consts.FlagKeysName, app.DefaultKeysPath(), consts.FlagKeysHelp)
+	return func() (*app.KeyMap, error) {
+		km, err := app.LoadKeyMap(*path)
+		if err != nil {
+			return nil, err
+		}
+		return &km, nil
+	}
+}
+
+// registerResetTimeFlags defines -clock, -tz, -utc and -reset-time on fs and
+// returns a function that applies them to every reset time shown.
+func registerResetTimeFlags(fs *flag.FlagSet) func() error {
+	clock := fs.String(consts.FlagClockName, consts.Clock24h, consts.FlagClockHelp)
+	tz := fs.String(consts.FlagTZName, "", consts.FlagTZHelp)
+	utc := fs.Bool(consts.FlagUTCName, false, consts.FlagUTCHelp)
+	show := fs.String(consts.FlagResetTimeName, consts.ResetTimeBoth, consts.FlagResetTimeHelp)
+	return func() error {
+		f, err := utils.ParseResetFormat(*clock, *tz, *utc, *show)
+		if err != nil {
+			return err
+		}
+		utils.SetResetFormat(f)
+		return nil
+	}
+}
+
+// openSampleLog returns the sample log at path, or nil when recording is
+// disabled with an empty path.
+func openSampleLog(path string) *store.SampleLog {
+	if strings.TrimSpace(path) == "" {
+		return nil
+	}
+	return store.NewSampleLog(path)
+}
+
+// openSnapshot returns the snapshot file at path, or nil when caching is
+// disabled with an empty path.
+func openSnapshot(path string) *store.SnapshotFile {
+	if strings.TrimSpace(path) == "" {
+		return nil
+	}
+	return store.NewSnapshotFile(path)
+}
+
+// openBetaState returns the remembered beta header file at path, or nil when
+// it is disabled with an empty path.
+func openBetaState(path string) *store.BetaFile {
+	if strings.TrimSpace(path) == "" {
+		return nil
+	}
+	return store.NewBetaFile(path)
+}
+
+// splitList splits a comma-separated flag value, dropping blanks.
+func splitList(v string) []string {
+	var out []string
+	for _, part := range strings.Split(v, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+func loadTimeoutDefault() (time.Duration, string) {
+	if v := strings.TrimSpace(os.Getenv(consts.EnvHTTPTimeout)); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			return d, ""
+		}
+		return defaultHTTPTimeout, fmt.Sprintf(consts.TextTimeoutEnvWarningFmt, consts.EnvHTTPTimeout, v, defaultHTTPTimeout)
+	}
+	return defaultHTTPTimeout, ""
+}
+
+// betaExplicit reports whether the beta header was given with -beta-header
+// or ANTHROPIC_BETA_HEADER instead of falling back to the baked-in default.
+func (f *configFlags) betaExplicit() bool {
+	if strings.TrimSpace(os.Getenv(consts.EnvBetaHeader)) != "" {
+		return true
+	}
+	set := false
+	f.fs.Visit(func(fl *flag.Flag) {
+		if fl.Name == consts.FlagBetaName {
+			set = true
+		}
+	})
+	return set
+}
+
+// loadThemeDefault returns the theme named by CLAUDE_MONITOR_THEME, or
+// high-contrast when CLAUDE_MONITOR_HIGH_CONTRAST is truthy; empty selects
+// the built-in default.
+func loadThemeDefault() string {
+	if v := strings.TrimSpace(os.Getenv(consts.EnvTheme)); v != "" {
+		return v
+	}
+	if isTruthy(os.Getenv(consts.EnvHighContrast)) {
+		return consts.ThemeHighContrast
+	}
+	return ""
+}
+
+// isTruthy reports whether an env value switches a setting on.
+func isTruthy(v string) bool {
+	v = strings.TrimSpace(strings.ToLower(v))
+	return v == "1" || v == "true" || v == "yes" || v == "on"
+}
+
+// loadBetaDefault returns the beta header from environment or the baked-in
+// default that ships with the binary.
+func loadBetaDefault() string {
+	if v := strings.TrimSpace(os.Getenv(consts.EnvBetaHeader)); v != "" {
+		return v
+	}
+	return consts.DefaultBetaName
+}
+
+func newHTTPClient(timeout time.Duration) *http.Client {
+	tr, ok := http.DefaultTransport.(*http.Transport)
+	if ok && tr != nil {
+		tr = tr.Clone()
+		tr.MaxIdleConns = 32
+		tr.MaxIdleConnsPerHost = 8
+		tr.IdleConnTimeout = 30 * time.Second
+		if timeout > 0 {
+			tr.ResponseHeaderTimeout = timeout
+		}
+		return &http.Client{Timeout: timeout, Transport: tr}
+	}
+	return &http.Client{Timeout: timeout}
+}
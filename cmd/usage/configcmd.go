@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"claude-monitor/internal/auth"
+	"claude-monitor/internal/consts"
+)
+
+// effectiveConfig is the resolved configuration printed by the config
+// command. It never includes the token itself.
+type effectiveConfig struct {
+	TokenSource    string   `json:"token_source"`
+	TokenError     string   `json:"token_error,omitempty"`
+	Credentials    string   `json:"credentials"`
+	Interval       string   `json:"interval"`
+	MinInterval    string   `json:"min_interval"`
+	MaxInterval    string   `json:"max_interval"`
+	Threshold      float64  `json:"threshold"`
+	HTTPTimeout    string   `json:"http_timeout"`
+	BetaHeader     string   `json:"beta_header"`
+	BetaCandidates []string `json:"beta_candidates"`
+	BetaRemembered string   `json:"beta_remembered,omitempty"`
+	BetaState      string   `json:"beta_state"`
+	History        string   `json:"history"`
+	Cache          string   `json:"cache"`
+}
+
+// configCommand prints the configuration the other commands would use after
+// flags, environment variables, and defaults are applied. Token problems are
+// reported rather than treated as fatal.
+func configCommand(fs *flag.FlagSet) runFunc {
+	flags := registerConfigFlags(fs)
+	format := fs.String(consts.FlagFormatName, consts.FormatText, consts.FlagStatusFormatHelp)
+
+	return func(context.Context) (int, error) {
+		ec := effectiveConfig{
+			TokenSource: consts.ConfigSourceFile,
+			Credentials: *flags.credPath,
+			Interval:    flags.refresh.String(),
+			MinInterval: orDerived(*flags.minRefresh, *flags.refresh/4).String(),
+			MaxInterval: orDerived(*flags.maxRefresh, *flags.refresh*8).String(),
+			Threshold:   *flags.threshold,
+			HTTPTimeout: flags.httpTimeout.String(),
+			BetaState:   *flags.betaStatePath,
+			History:     *flags.historyPath,
+			Cache:       *flags.cachePath,
+		}
+		if auth.TokenFromEnv() {
+			ec.TokenSource = consts.ConfigSourceEnv
+		}
+		if _, err := auth.ResolveToken(*flags.credPath); err != nil {
+			ec.TokenError = err.Error()
+		}
+		betas := splitList(*flags.betaHeader)
+		if len(betas) > 0 {
+			ec.BetaHeader, betas = betas[0], betas[1:]
+		}
+		for _, b := range betas {
+			if b != ec.BetaHeader && !slices.Contains(ec.BetaCandidates, b) {
+				ec.BetaCandidates = append(ec.BetaCandidates, b)
+			}
+		}
+		if st := openBetaState(*flags.betaStatePath); st != nil {
+			ec.BetaRemembered = st.Load()
+		}
+
+		switch *format {
+		case consts.FormatJSON:
+			out, err := json.MarshalIndent(ec, "", "  ")
+			if err != nil {
+				return 1, err
+			}
+			fmt.Fprintln(os.Stdout, string(out))
+		case consts.FormatText:
+			writeConfigText(ec)
+		default:
+			return 1, fmt.Errorf(consts.ErrFormatFmt, *format)
+		}
+		return 0, nil
+	}
+}
+
+// orDerived returns d, or derived when d is unset, mirroring how the
+// scheduler fills in its bounds.
+func orDerived(d, derived time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+	return derived
+}
+
+// tokenSourceText translates a token source identifier for text output.
+func tokenSourceText(source string) string {
+	if source == consts.ConfigSourceEnv {
+		return consts.ConfigTokenEnv
+	}
+	return consts.ConfigTokenFile
+}
+
+// writeConfigText prints one aligned "key  value" line per setting, using
+// the JSON field names as keys and skipping empty optional values.
+func writeConfigText(ec effectiveConfig) {
+	rows := [][2]string{
+		{"token_source", tokenSourceText(ec.TokenSource)},
+		{"token_error", ec.TokenError},
+		{"credentials", ec.Credentials},
+		{"interval", ec.Interval},
+		{"min_interval", ec.MinInterval},
+		{"max_interval", ec.MaxInterval},
+		{"threshold", strconv.FormatFloat(ec.Threshold, 'f', -1, 64)},
+		{"http_timeout", ec.HTTPTimeout},
+		{"beta_header", ec.BetaHeader},
+		{"beta_candidates", strings.Join(ec.BetaCandidates, ",")},
+		{"beta_remembered", ec.BetaRemembered},
+		{"beta_state", ec.BetaState},
+		{"history", ec.History},
+		{"cache", ec.Cache},
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, r := range rows {
+		if r[1] == "" && (r[0] == "token_error" || r[0] == "beta_remembered") {
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%s\n", r[0], r[1])
+	}
+	tw.Flush()
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"claude-monitor/internal/apitest"
+	"claude-monitor/internal/app"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
+)
+
+// demoCommand runs the TUI against apitest's scripted fake endpoint. Samples
+// go to a temporary log so the history view works without touching the real
+// one; nothing else is persisted.
+func demoCommand(fs *flag.FlagSet) runFunc {
+	interval := fs.Duration(consts.FlagIntervalName, 2*time.Second, consts.FlagIntervalHelp)
+	threshold := fs.Float64(consts.FlagThresholdName, 80, consts.FlagThresholdHelp)
+	loop := fs.Bool(consts.FlagLoopName, true, consts.FlagLoopHelp)
+	style := registerStyleFlags(fs)
+	keys := registerKeysFlag(fs)
+	resetTime := registerResetTimeFlags(fs)
+	layout := fs.String(consts.FlagLayoutName, consts.LayoutAutoName, consts.FlagLayoutHelp)
+	mouse := fs.Bool(consts.FlagMouseName, true, consts.FlagMouseHelp)
+
+	return func(ctx context.Context) (int, error) {
+		if err := resetTime(); err != nil {
+			return 1, err
+		}
+		cycle, err := style.themes()
+		if err != nil {
+			return 1, err
+		}
+		bands, err := style.severity(*threshold)
+		if err != nil {
+			return 1, err
+		}
+		keyMap, err := keys()
+		if err != nil {
+			return 1, err
+		}
+		mode, err := app.ParseLayout(*layout)
+		if err != nil {
+			return 1, err
+		}
+		srv := apitest.NewServer(apitest.DemoScript()...)
+		srv.Loop = *loop
+		defer srv.Close()
+
+		dir, err := os.MkdirTemp("", consts.AppDirName+"-demo-")
+		if err != nil {
+			return 1, err
+		}
+		defer os.RemoveAll(dir)
+
+		cfg := app.Config{
+			Token:        consts.DemoToken,
+			RefreshEvery: *interval,
+			Threshold:    *threshold,
+			HTTPClient:   srv.Client(defaultHTTPTimeout),
+			BetaHeader:   consts.DefaultBetaName,
+			Samples:      store.NewSampleLog(filepath.Join(dir, consts.SamplesFileName)),
+			Themes:       cycle,
+			Bands:        bands,
+			Keys:         keyMap,
+			Layout:       mode,
+			NoMouse:      !*mouse,
+		}
+		if err := cfg.Validate(); err != nil {
+			return 1, fmt.Errorf(consts.TextConfigErrFmt, err)
+		}
+		if err := app.Run(ctx, cfg); err != nil {
+			return 1, fmt.Errorf(consts.TextAppErrFmt, err)
+		}
+		return 0, nil
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"claude-monitor/internal/app"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/doctor"
+)
+
+// doctorCommand runs the diagnostic checklist and prints it as text or JSON.
+// It exits 1 when any check failed.
+func doctorCommand(fs *flag.FlagSet) runFunc {
+	flags := registerConfigFlags(fs)
+	format := fs.String(consts.FlagFormatName, consts.FormatText, consts.FlagDoctorFormatHelp)
+
+	return func(ctx context.Context) (int, error) {
+		betas := splitList(*flags.betaHeader)
+		if len(betas) == 0 {
+			betas = []string{""}
+		}
+		rep := doctor.Run(ctx, doctor.Options{
+			CredPath:   *flags.credPath,
+			BetaHeader: betas[0],
+			Client:     newHTTPClient(*flags.httpTimeout),
+		})
+		out, err := app.RenderDoctor(rep, *format)
+		if err != nil {
+			return 1, err
+		}
+		fmt.Fprint(os.Stdout, out)
+		if rep.Failed() {
+			return 1, nil
+		}
+		return 0, nil
+	}
+}
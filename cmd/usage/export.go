@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/export"
+	"claude-monitor/internal/store"
+	"claude-monitor/internal/utils"
+)
+
+// exportCommand writes recorded samples to stdout or a file in the requested
+// format.
+func exportCommand(fs *flag.FlagSet) runFunc {
+	since := fs.String(consts.FlagSinceName, "", consts.FlagExportSinceHelp)
+	from := fs.String(consts.FlagFromName, "", consts.FlagFromHelp)
+	until := fs.String(consts.FlagUntilName, "", consts.FlagUntilHelp)
+	window := fs.String(consts.FlagWindowName, "", consts.FlagWindowFilterHelp)
+	format := fs.String(consts.FlagFormatName, consts.FormatCSV, consts.FlagExportFormatHelp)
+	timeFormat := fs.String(consts.FlagTimeFormatName, consts.TimeFormatRFC3339, consts.FlagTimeFormatHelp)
+	output := fs.String(consts.FlagOutputName, "", consts.FlagOutputHelp)
+	historyPath := fs.String(consts.FlagHistoryName, store.DefaultSamplesPath(), consts.FlagHistoryHelp)
+
+	return func(context.Context) (int, error) {
+		opt := export.Options{Format: *format, TimeFormat: *timeFormat, Window: *window}
+		if err := opt.Validate(); err != nil {
+			return 1, err
+		}
+
+		start, err := parseTimeFlag(*from)
+		if err != nil {
+			return 1, err
+		}
+		end, err := parseTimeFlag(*until)
+		if err != nil {
+			return 1, err
+		}
+		if strings.TrimSpace(*since) != "" {
+			span, err := utils.ParseSpan(*since)
+			if err != nil {
+				return 1, err
+			}
+			start = time.Now().Add(-span)
+		}
+
+		samples, err := store.NewSampleLog(*historyPath).Read(start, end)
+		if err != nil {
+			return 1, err
+		}
+
+		if *output == "" {
+			return exitCode(export.Write(os.Stdout, samples, opt))
+		}
+		f, err := os.Create(*output)
+		if err != nil {
+			return 1, err
+		}
+		if err := export.Write(f, samples, opt); err != nil {
+			f.Close()
+			return 1, err
+		}
+		return exitCode(f.Close())
+	}
+}
+
+// parseTimeFlag parses an optional RFC3339 flag value; empty yields the zero
+// time, which leaves that side of the range open.
+func parseTimeFlag(v string) (time.Time, error) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(consts.ErrTimeValueFmt, v)
+	}
+	return t, nil
+}
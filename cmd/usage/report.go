@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"claude-monitor/internal/app"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/report"
+	"claude-monitor/internal/store"
+	"claude-monitor/internal/utils"
+)
+
+// reportCommand loads recorded samples and prints the aggregated report to
+// stdout.
+func reportCommand(fs *flag.FlagSet) runFunc {
+	since := fs.String(consts.FlagSinceName, "7d", consts.FlagSinceHelp)
+	groupBy := fs.String(consts.FlagGroupByName, string(report.GroupDay), consts.FlagGroupByHelp)
+	format := fs.String(consts.FlagFormatName, consts.FormatText, consts.FlagReportFormatHelp)
+	threshold := fs.Float64(consts.FlagThresholdName, 80, consts.FlagThresholdHelp)
+	historyPath := fs.String(consts.FlagHistoryName, store.DefaultSamplesPath(), consts.FlagHistoryHelp)
+
+	return func(context.Context) (int, error) {
+		span, err := utils.ParseSpan(*since)
+		if err != nil {
+			return 1, err
+		}
+		group, err := report.ParseGroupBy(*groupBy)
+		if err != nil {
+			return 1, err
+		}
+
+		samples, err := store.NewSampleLog(*historyPath).Read(time.Now().Add(-span), time.Time{})
+		if err != nil {
+			return 1, err
+		}
+
+		rep := report.Build(samples, report.Options{GroupBy: group, Threshold: *threshold})
+		out, err := app.RenderReport(rep, *format)
+		if err != nil {
+			return 1, err
+		}
+		fmt.Fprint(os.Stdout, out)
+		return 0, nil
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/server"
+)
+
+// serveCommand polls usage and serves it over HTTP until interrupted.
+func serveCommand(fs *flag.FlagSet) runFunc {
+	flags := registerConfigFlags(fs)
+	addr := fs.String(consts.FlagAddrName, "127.0.0.1:9187", consts.FlagAddrHelp)
+
+	return func(ctx context.Context) (int, error) {
+		cfg, err := flags.config()
+		if err != nil {
+			return 1, err
+		}
+		return exitCode(server.New(cfg).Run(ctx, *addr, os.Stderr))
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"claude-monitor/internal/app"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
+)
+
+// snapshotCommand fetches usage once (or reads a recorded sample) and writes
+// the dashboard frame to stdout or a file.
+func snapshotCommand(fs *flag.FlagSet) runFunc {
+	flags := registerConfigFlags(fs)
+	format := fs.String(consts.FlagFormatName, consts.FormatText, consts.FlagSnapshotFormatHelp)
+	width := fs.Int(consts.FlagWidthName, 80, consts.FlagWidthHelp)
+	at := fs.String(consts.FlagAtName, "", consts.FlagAtHelp)
+	color := fs.String(consts.FlagColorName, "", consts.FlagColorHelp)
+	light := fs.Bool(consts.FlagLightName, false, consts.FlagLightHelp)
+	input := fs.String(consts.FlagInputName, "", consts.FlagInputHelp)
+	output := fs.String(consts.FlagOutputName, "", consts.FlagOutputHelp)
+	layout := fs.String(consts.FlagLayoutName, consts.LayoutAutoName, consts.FlagLayoutHelp)
+	style := registerStyleFlags(fs)
+	resetTime := registerResetTimeFlags(fs)
+
+	return func(ctx context.Context) (int, error) {
+		if err := resetTime(); err != nil {
+			return 1, err
+		}
+		if err := style.apply(); err != nil {
+			return 1, err
+		}
+		bands, err := style.severity(*flags.threshold)
+		if err != nil {
+			return 1, err
+		}
+		opt := app.SnapshotOptions{Format: *format, Width: *width, Profile: *color, Light: *light, Bands: bands}
+		if opt.Layout, err = app.ParseLayout(*layout); err != nil {
+			return 1, err
+		}
+		if err := opt.Validate(); err != nil {
+			return 1, err
+		}
+		clock, err := parseTimeFlag(*at)
+		if err != nil {
+			return 1, err
+		}
+		opt.At = clock
+
+		var sample store.Sample
+		if strings.TrimSpace(*input) != "" {
+			sample, err = readSample(*input)
+		} else {
+			var cfg app.Config
+			if cfg, err = flags.config(); err == nil {
+				sample, err = app.FetchStatus(ctx, cfg)
+			}
+		}
+		if err != nil {
+			return 1, err
+		}
+
+		out, err := app.RenderSnapshot(sample, opt)
+		if err != nil {
+			return 1, err
+		}
+		if *output == "" {
+			_, err = io.WriteString(os.Stdout, out)
+			return exitCode(err)
+		}
+		f, err := os.Create(*output)
+		if err != nil {
+			return 1, err
+		}
+		if _, err := io.WriteString(f, out); err != nil {
+			f.Close()
+			return 1, err
+		}
+		return exitCode(f.Close())
+	}
+}
+
+// readSample loads one sample JSON object; "-" reads stdin.
+func readSample(path string) (store.Sample, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	var s store.Sample
+	if err == nil {
+		err = json.Unmarshal(data, &s)
+	}
+	if err != nil {
+		return store.Sample{}, fmt.Errorf(consts.ErrSampleFileFmt, path, err)
+	}
+	return s, nil
+}
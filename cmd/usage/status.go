@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"claude-monitor/internal/app"
+	"claude-monitor/internal/consts"
+)
+
+// statusCommand fetches usage once and prints it as bars or JSON.
+func statusCommand(fs *flag.FlagSet) runFunc {
+	flags := registerConfigFlags(fs)
+	format := fs.String(consts.FlagFormatName, consts.FormatText, consts.FlagStatusFormatHelp)
+	width := fs.Int(consts.FlagWidthName, 60, consts.FlagWidthHelp)
+	style := registerStyleFlags(fs)
+	resetTime := registerResetTimeFlags(fs)
+
+	return func(ctx context.Context) (int, error) {
+		if err := resetTime(); err != nil {
+			return 1, err
+		}
+		if *format != consts.FormatText && *format != consts.FormatJSON {
+			return 1, fmt.Errorf(consts.ErrFormatFmt, *format)
+		}
+		if err := style.apply(); err != nil {
+			return 1, err
+		}
+		cfg, err := flags.config()
+		if err != nil {
+			return 1, err
+		}
+		bands, err := style.severity(cfg.Threshold)
+		if err != nil {
+			return 1, err
+		}
+		sample, err := app.FetchStatus(ctx, cfg)
+		if err != nil {
+			return 1, err
+		}
+		out, err := app.RenderStatus(sample, *width, *format, bands)
+		if err != nil {
+			return 1, err
+		}
+		fmt.Fprint(os.Stdout, out)
+		return 0, nil
+	}
+}
+
+// watchCommand prints usage after every poll until interrupted, inline in
+// the normal scrollback.
+func watchCommand(fs *flag.FlagSet) runFunc {
+	flags := registerConfigFlags(fs)
+	mode := fs.String(consts.FlagWatchModeName, consts.WatchModeLog, consts.FlagWatchModeHelp)
+	width := fs.Int(consts.FlagWidthName, 60, consts.FlagWidthHelp)
+	appendBlocks := fs.Bool(consts.FlagAppendName, false, consts.FlagAppendHelp)
+	style := registerStyleFlags(fs)
+	resetTime := registerResetTimeFlags(fs)
+
+	return func(ctx context.Context) (int, error) {
+		if err := resetTime(); err != nil {
+			return 1, err
+		}
+		opt := app.WatchOptions{Mode: *mode, Width: *width, Rewrite: !*appendBlocks && isTerminal(os.Stdout)}
+		if err := opt.Validate(); err != nil {
+			return 1, err
+		}
+		cfg, err := flags.config()
+		if err != nil {
+			return 1, err
+		}
+		if cfg.Themes, err = style.themes(); err != nil {
+			return 1, err
+		}
+		if opt.Bands, err = style.severity(cfg.Threshold); err != nil {
+			return 1, err
+		}
+		return exitCode(app.RunWatch(ctx, cfg, os.Stdout, opt))
+	}
+}
+
+// isTerminal reports whether f is a character device such as a terminal
+// rather than a pipe or file.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
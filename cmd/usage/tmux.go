@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"claude-monitor/internal/app"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
+)
+
+// tmuxCommand prints the tmux status snippet from the cached snapshot, or
+// with -refresh keeps the snapshot warm by polling in the foreground.
+func tmuxCommand(fs *flag.FlagSet) runFunc {
+	flags := registerConfigFlags(fs)
+	refresh := fs.Bool(consts.FlagRefreshName, false, consts.FlagRefreshHelp)
+	maxAge := fs.Duration(consts.FlagMaxAgeName, 10*time.Minute, consts.FlagMaxAgeHelp)
+	remaining := fs.Bool(consts.FlagRemainingName, false, consts.FlagRemainingHelp)
+	style := registerStyleFlags(fs)
+
+	return func(ctx context.Context) (int, error) {
+		if err := style.apply(); err != nil {
+			return 1, err
+		}
+		bands, err := style.severity(*flags.threshold)
+		if err != nil {
+			return 1, err
+		}
+		if *refresh {
+			cfg, err := flags.config()
+			if err != nil {
+				return 1, err
+			}
+			if cfg.Snapshot == nil {
+				return 1, errors.New(consts.ErrCacheRequired)
+			}
+			return exitCode(app.Poll(ctx, cfg, nil))
+		}
+
+		var snap *store.Sample
+		if *flags.cachePath != "" {
+			s, err := store.NewSnapshotFile(*flags.cachePath).Load()
+			switch {
+			case err == nil:
+				snap = &s
+			case !errors.Is(err, os.ErrNotExist):
+				return 1, err
+			}
+		}
+		fmt.Fprintln(os.Stdout, app.RenderTmux(snap, time.Now(), app.TmuxOptions{
+			MaxAge:    *maxAge,
+			Remaining: *remaining,
+			Threshold: *flags.threshold,
+			Bands:     bands,
+		}))
+		return 0, nil
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"claude-monitor/internal/app"
+	"claude-monitor/internal/consts"
+)
+
+// tuiCommand runs the interactive dashboard.
+func tuiCommand(fs *flag.FlagSet) runFunc {
+	flags := registerConfigFlags(fs)
+	style := registerStyleFlags(fs)
+	keys := registerKeysFlag(fs)
+	resetTime := registerResetTimeFlags(fs)
+	layout := fs.String(consts.FlagLayoutName, consts.LayoutAutoName, consts.FlagLayoutHelp)
+	mouse := fs.Bool(consts.FlagMouseName, true, consts.FlagMouseHelp)
+	return func(ctx context.Context) (int, error) {
+		if err := resetTime(); err != nil {
+			return 1, err
+		}
+		cfg, err := flags.config()
+		if err != nil {
+			return 1, err
+		}
+		if cfg.Themes, err = style.themes(); err != nil {
+			return 1, err
+		}
+		if cfg.Bands, err = style.severity(cfg.Threshold); err != nil {
+			return 1, err
+		}
+		if cfg.Keys, err = keys(); err != nil {
+			return 1, err
+		}
+		if cfg.Layout, err = app.ParseLayout(*layout); err != nil {
+			return 1, err
+		}
+		cfg.NoMouse = !*mouse
+		if err := app.Run(ctx, cfg); err != nil {
+			return 1, fmt.Errorf(consts.TextAppErrFmt, err)
+		}
+		return 0, nil
+	}
+}
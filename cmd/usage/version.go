@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"claude-monitor/internal/api"
+	"claude-monitor/internal/buildinfo"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
+)
+
+// versionInfo is the version command's output: build metadata plus the
+// settings most often needed to triage a bug report.
+type versionInfo struct {
+	buildinfo.Info
+	BetaHeader     string `json:"beta_header"`
+	BetaRemembered bool   `json:"beta_remembered"`
+	APIBaseURL     string `json:"api_base_url"`
+}
+
+// versionCommand prints version, VCS revision, dirty flag, commit time, Go
+// version, the effective beta header, and the API base URL.
+func versionCommand(fs *flag.FlagSet) runFunc {
+	asJSON := fs.Bool(consts.FlagJSONName, false, consts.FlagJSONHelp)
+
+	return func(context.Context) (int, error) {
+		vi := versionInfo{Info: buildinfo.Read(), APIBaseURL: api.BaseURL}
+		if betas := splitList(loadBetaDefault()); len(betas) > 0 {
+			vi.BetaHeader = betas[0]
+		}
+		// The remembered value only replaces the baked-in default; an
+		// ANTHROPIC_BETA_HEADER value is tried first, as in every mode.
+		if strings.TrimSpace(os.Getenv(consts.EnvBetaHeader)) == "" {
+			if remembered := store.NewBetaFile(store.DefaultBetaPath()).Load(); remembered != "" {
+				vi.BetaHeader, vi.BetaRemembered = remembered, true
+			}
+		}
+
+		if *asJSON {
+			out, err := json.MarshalIndent(vi, "", "  ")
+			if err != nil {
+				return 1, err
+			}
+			fmt.Fprintln(os.Stdout, string(out))
+			return 0, nil
+		}
+
+		fmt.Fprintf(os.Stdout, consts.TextVersionFmt+"\n", consts.ProgramName, vi.Version)
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		if vi.Revision != "" {
+			rev := vi.Revision
+			if vi.Dirty {
+				rev += " " + consts.TextDirty
+			}
+			fmt.Fprintf(tw, consts.VersionRowFmt, consts.TextVersionRevision, rev)
+		}
+		if !vi.Time.IsZero() {
+			fmt.Fprintf(tw, consts.VersionRowFmt, consts.TextVersionCommitTime, vi.Time.UTC().Format(time.RFC3339))
+		}
+		if vi.GoVersion != "" {
+			fmt.Fprintf(tw, consts.VersionRowFmt, consts.TextVersionGo, vi.GoVersion)
+		}
+		beta := vi.BetaHeader
+		if vi.BetaRemembered {
+			beta += " " + consts.TextBetaRemembered
+		}
+		fmt.Fprintf(tw, consts.VersionRowFmt, consts.TextVersionBeta, beta)
+		fmt.Fprintf(tw, consts.VersionRowFmt, consts.TextVersionAPI, vi.APIBaseURL)
+		tw.Flush()
+		return 0, nil
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"os/exec"
+
+	"claude-monitor/internal/app"
+	"claude-monitor/internal/consts"
+)
+
+// waitCommand blocks until the selected window has quota, then runs the
+// command given after "--" (if any) with the caller's stdio. It exits with
+// the command's status, or 0 when no command was given.
+func waitCommand(fs *flag.FlagSet) runFunc {
+	flags := registerConfigFlags(fs)
+	below := fs.Float64(consts.FlagBelowName, 80, consts.FlagBelowHelp)
+	window := fs.String(consts.FlagWindowName, consts.WindowFiveHour, consts.FlagWaitWindowHelp)
+	noWait := fs.Bool(consts.FlagNoWaitName, false, consts.FlagNoWaitHelp)
+	resetTime := registerResetTimeFlags(fs)
+
+	return func(ctx context.Context) (int, error) {
+		if err := resetTime(); err != nil {
+			return 1, err
+		}
+		opt := app.WaitOptions{Below: *below, Window: *window, NoWait: *noWait, Log: os.Stderr}
+		if err := opt.Validate(); err != nil {
+			return 1, err
+		}
+		cfg, err := flags.config()
+		if err != nil {
+			return 1, err
+		}
+		if err := app.WaitForQuota(ctx, cfg, opt); err != nil {
+			return 1, err
+		}
+
+		command := fs.Args()
+		if len(command) == 0 {
+			return 0, nil
+		}
+		cmd := exec.Command(command[0], command[1:]...)
+		cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+		if err := cmd.Run(); err != nil {
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				return exitErr.ExitCode(), nil
+			}
+			return 1, err
+		}
+		return 0, nil
+	}
+}
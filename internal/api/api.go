@@ -14,7 +14,12 @@ import (
 )
 
 const (
-	apiURL = "https://api.anthropic.com/api/oauth/usage"
+	// Host is the API host name.
+	Host = "api.anthropic.com"
+	// BaseURL is the API origin.
+	BaseURL = "https://" + Host
+	// URL is the OAuth usage endpoint.
+	URL = BaseURL + "/api/oauth/usage"
 )
 
 // HTTPError captures structured details from non-2xx API responses.
@@ -22,6 +27,31 @@ type HTTPError struct {
 	Status     int
 	Body       string
 	RetryAfter time.Duration
+	// BetaRelated is set when a 400/401/403 body points at the anthropic-beta
+	// header rather than the token.
+	BetaRelated bool
+}
+
+// betaErrorMarkers are lower-case body fragments the API returns when the
+// anthropic-beta header is missing, unknown, or retired.
+var betaErrorMarkers = []string{
+	"beta",
+	"oauth authentication is currently not supported",
+}
+
+// IsBetaError reports whether err is an HTTPError caused by the beta header,
+// meaning a different header value may succeed.
+//
+// Parameters:
+//
+//	err - error returned by FetchUsage.
+//
+// Returns:
+//
+//	bool - true for 400/401/403 responses whose body mentions the beta header.
+func IsBetaError(err error) bool {
+	var httpErr HTTPError
+	return errors.As(err, &httpErr) && httpErr.BetaRelated
 }
 
 func (e HTTPError) Error() string {
@@ -61,7 +91,7 @@ func FetchUsage(ctx context.Context, client HTTPClient, token, betaHeader string
 		return UsageResponse{}, errors.New(consts.ErrBetaHeaderRequired)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL, nil)
 	if err != nil {
 		return UsageResponse{}, err
 	}
@@ -78,13 +108,15 @@ func FetchUsage(ctx context.Context, client HTTPClient, token, betaHeader string
 	if resp.StatusCode >= 300 {
 		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4_096))
 		bodyText := strings.TrimSpace(string(body))
+		betaRelated := isBetaStatus(resp.StatusCode) && mentionsBeta(bodyText)
 		if betaHeader == consts.DefaultBetaName {
 			bodyText = bodyText + " (beta header may be outdated; set -beta-header or ANTHROPIC_BETA_HEADER)"
 		}
 		return UsageResponse{}, HTTPError{
-			Status:     resp.StatusCode,
-			Body:       bodyText,
-			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+			Status:      resp.StatusCode,
+			Body:        bodyText,
+			RetryAfter:  parseRetryAfter(resp.Header.Get("Retry-After")),
+			BetaRelated: betaRelated,
 		}
 	}
 
@@ -101,6 +133,23 @@ type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
+// isBetaStatus reports whether status is one the API uses for a bad beta
+// header.
+func isBetaStatus(status int) bool {
+	return status == http.StatusBadRequest || status == http.StatusUnauthorized || status == http.StatusForbidden
+}
+
+// mentionsBeta reports whether an error body matches betaErrorMarkers.
+func mentionsBeta(body string) bool {
+	lower := strings.ToLower(body)
+	for _, m := range betaErrorMarkers {
+		if strings.Contains(lower, m) {
+			return true
+		}
+	}
+	return false
+}
+
 // parseRetryAfter converts Retry-After header into a duration when possible.
 func parseRetryAfter(raw string) time.Duration {
 	if raw == "" {
@@ -142,3 +191,23 @@ func (w *WindowUsage) UnmarshalJSON(data []byte) error {
 	}
 	return nil
 }
+
+// MarshalJSON encodes a window using the same field names the API returns so
+// that locally recorded samples round-trip through UnmarshalJSON.
+//
+// Returns:
+//
+//	[]byte - JSON object with utilization and optional resets_at.
+//	error  - non-nil when encoding fails.
+func (w WindowUsage) MarshalJSON() ([]byte, error) {
+	var raw struct {
+		Utilization *float64 `json:"utilization"`
+		ResetsAtRaw *string  `json:"resets_at,omitempty"`
+	}
+	raw.Utilization = w.Utilization
+	if w.ResetsAt != nil {
+		ts := w.ResetsAt.Format(time.RFC3339Nano)
+		raw.ResetsAtRaw = &ts
+	}
+	return json.Marshal(raw)
+}
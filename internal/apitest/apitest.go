@@ -0,0 +1,202 @@
+package apitest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"claude-monitor/internal/utils"
+)
+
+// Window scripts one usage window. Reset times are relative to when the
+// response is served, so scripts behave the same whenever they run.
+type Window struct {
+	Utilization float64
+	// ResetIn is the time from the response until the window resets; zero
+	// omits resets_at.
+	ResetIn time.Duration
+}
+
+// Step is one scripted response.
+type Step struct {
+	// Status is the HTTP status; zero means 200.
+	Status int
+	// FiveHour and SevenDay build the JSON body for 2xx responses.
+	FiveHour *Window
+	SevenDay *Window
+	// Body replaces the generated body verbatim (for error payloads and
+	// malformed JSON).
+	Body string
+	// RetryAfter sets the Retry-After header when positive.
+	RetryAfter time.Duration
+	// Delay holds the response back, to exercise timeouts.
+	Delay time.Duration
+	// Repeat serves the step this many times; zero means once.
+	Repeat int
+}
+
+// Server is an httptest-based fake of the OAuth usage endpoint that serves
+// Steps in order. After the last step it either loops or keeps serving the
+// final step.
+type Server struct {
+	*httptest.Server
+
+	// Loop restarts the script after the last step.
+	Loop bool
+	// AcceptBeta, when non-empty, rejects other anthropic-beta values with the
+	// API's 401 so header fallback can be exercised.
+	AcceptBeta []string
+
+	mu       sync.Mutex
+	steps    []Step
+	idx      int
+	served   int
+	requests int
+}
+
+// NewServer starts a fake usage endpoint serving steps.
+//
+// Parameters:
+//   - steps: scripted responses, served in order.
+//
+// Returns:
+//   - a running server; call Close when done.
+func NewServer(steps ...Step) *Server {
+	s := &Server{steps: steps}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Client returns an HTTP client that sends every request, whatever its URL,
+// to the fake server, so the production endpoint constant needs no override.
+//
+// Parameters:
+//   - timeout: client timeout.
+//
+// Returns:
+//   - client routed to the server.
+func (s *Server) Client(timeout time.Duration) *http.Client {
+	target, _ := url.Parse(s.URL)
+	base := s.Server.Client().Transport
+	return &http.Client{
+		Timeout: timeout,
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			req.URL.Scheme, req.URL.Host = target.Scheme, target.Host
+			req.Host = target.Host
+			return base.RoundTrip(req)
+		}),
+	}
+}
+
+// Requests reports how many requests the server has received.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// next returns the step to serve and advances the script.
+func (s *Server) next() Step {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.steps) == 0 {
+		return Step{Status: http.StatusNotFound, Body: `{"error":{"message":"no scripted steps"}}`}
+	}
+	step := s.steps[s.idx]
+	s.served++
+	if s.served >= utils.Max(step.Repeat, 1) {
+		s.served = 0
+		switch {
+		case s.idx+1 < len(s.steps):
+			s.idx++
+		case s.Loop:
+			s.idx = 0
+		default:
+			// Keep serving the final step.
+		}
+	}
+	return step
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	s.mu.Unlock()
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeError(w, http.StatusUnauthorized, "missing bearer token")
+		return
+	}
+	if len(s.AcceptBeta) > 0 && !contains(s.AcceptBeta, r.Header.Get("anthropic-beta")) {
+		writeError(w, http.StatusUnauthorized, "OAuth authentication is currently not supported.")
+		return
+	}
+
+	step := s.next()
+	if step.Delay > 0 {
+		select {
+		case <-time.After(step.Delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+	if step.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(step.RetryAfter.Round(time.Second)/time.Second)))
+	}
+	status := step.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	body := step.Body
+	if body == "" && status < 300 {
+		body = usageBody(step, time.Now())
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprint(w, body)
+}
+
+// usageBody renders a step's windows as the API's JSON.
+func usageBody(step Step, now time.Time) string {
+	out := map[string]any{}
+	for key, win := range map[string]*Window{"five_hour": step.FiveHour, "seven_day": step.SevenDay} {
+		if win == nil {
+			continue
+		}
+		obj := map[string]any{"utilization": win.Utilization}
+		if win.ResetIn > 0 {
+			obj["resets_at"] = now.Add(win.ResetIn).UTC().Format(time.RFC3339Nano)
+		}
+		out[key] = obj
+	}
+	data, _ := json.Marshal(out)
+	return string(data)
+}
+
+// writeError writes an API-shaped error body.
+func writeError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	data, _ := json.Marshal(map[string]any{"type": "error", "error": map[string]string{"type": "error", "message": msg}})
+	w.Write(data)
+}
+
+func contains(list []string, v string) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
@@ -0,0 +1,85 @@
+package apitest
+
+import (
+	"net/http"
+	"time"
+)
+
+// Ramp returns n steps moving the 5-hour window from one utilization to
+// another, with a fixed 7-day window and reset distances.
+//
+// Parameters:
+//   - from, to: first and last 5-hour utilization.
+//   - n: number of steps (at least 2 for a ramp).
+//   - weekly: 7-day utilization served alongside.
+//   - resetIn: 5-hour reset distance for every step.
+//
+// Returns:
+//   - steps in order.
+func Ramp(from, to float64, n int, weekly float64, resetIn time.Duration) []Step {
+	steps := make([]Step, 0, n)
+	for i := 0; i < n; i++ {
+		pct := from
+		if n > 1 {
+			pct = from + (to-from)*float64(i)/float64(n-1)
+		}
+		steps = append(steps, Step{
+			FiveHour: &Window{Utilization: pct, ResetIn: resetIn},
+			SevenDay: &Window{Utilization: weekly, ResetIn: 4 * 24 * time.Hour},
+		})
+	}
+	return steps
+}
+
+// Reset returns a step right after a 5-hour rollover: low utilization and a
+// fresh five-hour reset distance.
+func Reset(weekly float64) Step {
+	return Step{
+		FiveHour: &Window{Utilization: 1, ResetIn: 5 * time.Hour},
+		SevenDay: &Window{Utilization: weekly, ResetIn: 4 * 24 * time.Hour},
+	}
+}
+
+// RateLimited returns a 429 carrying Retry-After.
+func RateLimited(retryAfter time.Duration) Step {
+	return Step{
+		Status:     http.StatusTooManyRequests,
+		Body:       `{"type":"error","error":{"type":"rate_limit_error","message":"Rate limited"}}`,
+		RetryAfter: retryAfter,
+	}
+}
+
+// ServerErrors returns n consecutive 5xx responses with the given status.
+func ServerErrors(status, n int) Step {
+	return Step{
+		Status: status,
+		Body:   `{"type":"error","error":{"type":"api_error","message":"Internal server error"}}`,
+		Repeat: n,
+	}
+}
+
+// Malformed returns a 200 whose body is not valid JSON.
+func Malformed() Step {
+	return Step{Body: `{"five_hour": {"utilization": 4`}
+}
+
+// Slow delays step by d, e.g. past the client timeout.
+func Slow(d time.Duration, step Step) Step {
+	step.Delay = d
+	return step
+}
+
+// DemoScript is the scripted session used by the demo command: climbing
+// usage, a rate limit, a burst of server errors, a malformed body, a response
+// slower than the client timeout, then a window reset.
+func DemoScript() []Step {
+	var steps []Step
+	steps = append(steps, Ramp(12, 64, 6, 38, 2*time.Hour)...)
+	steps = append(steps, RateLimited(5*time.Second))
+	steps = append(steps, Ramp(68, 97, 5, 41, 40*time.Minute)...)
+	steps = append(steps, ServerErrors(http.StatusServiceUnavailable, 3))
+	steps = append(steps, Malformed())
+	steps = append(steps, Slow(30*time.Second, Reset(42)), Reset(42))
+	steps = append(steps, Ramp(1, 9, 4, 42, 5*time.Hour)...)
+	return steps
+}
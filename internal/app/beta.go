@@ -0,0 +1,84 @@
+package app
+
+import (
+	"context"
+	"strings"
+
+	"claude-monitor/internal/api"
+	"claude-monitor/internal/consts"
+)
+
+// betaOrder lists the anthropic-beta values to try, most likely first. An
+// explicitly configured header wins over the remembered working value, which
+// only stands in for the baked-in default; the remaining configured
+// fallbacks follow, and the built-in candidates always come last.
+func betaOrder(cfg Config) []string {
+	seen := map[string]bool{}
+	var order []string
+	add := func(v string) {
+		v = strings.TrimSpace(v)
+		if v != "" && !seen[v] {
+			seen[v] = true
+			order = append(order, v)
+		}
+	}
+	var remembered string
+	if cfg.BetaState != nil {
+		remembered = cfg.BetaState.Load()
+	}
+	if cfg.BetaExplicit {
+		add(cfg.BetaHeader)
+		for _, v := range cfg.BetaCandidates {
+			add(v)
+		}
+		add(remembered)
+	} else {
+		add(remembered)
+		add(cfg.BetaHeader)
+		for _, v := range cfg.BetaCandidates {
+			add(v)
+		}
+	}
+	for _, v := range consts.BuiltinBetaCandidates {
+		add(v)
+	}
+	return order
+}
+
+// fetchUsage fetches usage, moving down betaOrder while the API rejects the
+// beta header, and remembers the value that works.
+//
+// Parameters:
+//   - ctx: request context shared by all attempts.
+//   - cfg: provides client, token, beta values, and the beta state file.
+//
+// Returns:
+//   - usage response.
+//   - the beta header value that produced it (empty on error).
+//   - the first non-beta error, since that candidate's header was accepted;
+//     when every candidate is rejected for its header, the first attempt's
+//     error, which names the header the user is most likely to recognize.
+func fetchUsage(ctx context.Context, cfg Config) (api.UsageResponse, string, error) {
+	var firstErr error
+	for _, beta := range betaOrder(cfg) {
+		data, err := api.FetchUsage(ctx, cfg.HTTPClient, cfg.Token, beta)
+		if err == nil {
+			if cfg.BetaState != nil {
+				_ = cfg.BetaState.Save(beta)
+			}
+			return data, beta, nil
+		}
+		if !api.IsBetaError(err) {
+			// This candidate got past the header check, so its error is
+			// the real one.
+			return api.UsageResponse{}, "", err
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return api.UsageResponse{}, "", firstErr
+}
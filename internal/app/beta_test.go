@@ -0,0 +1,108 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"claude-monitor/internal/api"
+	"claude-monitor/internal/apitest"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
+)
+
+// betaConfig returns a Config talking to srv with a fresh beta state file.
+func betaConfig(t *testing.T, srv *apitest.Server, header string, candidates ...string) Config {
+	return Config{
+		Token:          "test",
+		HTTPClient:     srv.Client(time.Second),
+		BetaHeader:     header,
+		BetaCandidates: candidates,
+		BetaState:      store.NewBetaFile(filepath.Join(t.TempDir(), "beta.json")),
+	}
+}
+
+func TestFetchUsageFallsBackAndRemembers(t *testing.T) {
+	srv := apitest.NewServer(apitest.Reset(10))
+	srv.AcceptBeta = []string{"new-beta"}
+	defer srv.Close()
+	cfg := betaConfig(t, srv, "old-beta", "new-beta")
+
+	_, beta, err := fetchUsage(context.Background(), cfg)
+	if err != nil || beta != "new-beta" {
+		t.Fatalf("got beta %q, err %v; want new-beta", beta, err)
+	}
+	if srv.Requests() != 2 {
+		t.Fatalf("%d requests, want 2", srv.Requests())
+	}
+	if got := cfg.BetaState.Load(); got != "new-beta" {
+		t.Fatalf("remembered %q, want new-beta", got)
+	}
+	// The remembered value now goes ahead of the default header.
+	if order := betaOrder(cfg); order[0] != "new-beta" {
+		t.Fatalf("order after remembering: %v", order)
+	}
+}
+
+func TestFetchUsageExplicitHeaderFirst(t *testing.T) {
+	srv := apitest.NewServer(apitest.Reset(10))
+	defer srv.Close()
+	cfg := betaConfig(t, srv, "explicit")
+	cfg.BetaExplicit = true
+	if err := cfg.BetaState.Save("remembered"); err != nil {
+		t.Fatal(err)
+	}
+
+	if order := betaOrder(cfg); len(order) != 3 || order[0] != "explicit" || order[1] != "remembered" {
+		t.Fatalf("order %v, want explicit, remembered, then the built-in default", order)
+	}
+	if _, beta, err := fetchUsage(context.Background(), cfg); err != nil || beta != "explicit" {
+		t.Fatalf("got beta %q, err %v; want explicit", beta, err)
+	}
+}
+
+func TestFetchUsageFallsBackToBuiltin(t *testing.T) {
+	srv := apitest.NewServer(apitest.Reset(10))
+	srv.AcceptBeta = []string{consts.DefaultBetaName}
+	defer srv.Close()
+	cfg := betaConfig(t, srv, "stale")
+	cfg.BetaExplicit = true
+
+	if _, beta, err := fetchUsage(context.Background(), cfg); err != nil || beta != consts.DefaultBetaName {
+		t.Fatalf("got beta %q, err %v; want the built-in %s", beta, err, consts.DefaultBetaName)
+	}
+}
+
+func TestFetchUsageReportsRealError(t *testing.T) {
+	srv := apitest.NewServer(apitest.ServerErrors(http.StatusInternalServerError, 1))
+	srv.AcceptBeta = []string{"new-beta"}
+	defer srv.Close()
+	cfg := betaConfig(t, srv, "old-beta", "new-beta", "unused-beta")
+
+	_, _, err := fetchUsage(context.Background(), cfg)
+	var httpErr api.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Status != http.StatusInternalServerError {
+		t.Fatalf("got %v, want the 500 from the accepted header", err)
+	}
+	if srv.Requests() != 2 {
+		t.Fatalf("%d requests, want 2 (no retry past the accepted header)", srv.Requests())
+	}
+}
+
+func TestFetchUsageAllRejected(t *testing.T) {
+	srv := apitest.NewServer(apitest.Reset(10))
+	srv.AcceptBeta = []string{"other"}
+	defer srv.Close()
+	cfg := betaConfig(t, srv, "first", "second")
+
+	_, _, err := fetchUsage(context.Background(), cfg)
+	if !api.IsBetaError(err) {
+		t.Fatalf("got %v, want the beta rejection", err)
+	}
+	if got := cfg.BetaState.Load(); got != "" {
+		t.Fatalf("remembered %q after every header failed", got)
+	}
+}
@@ -0,0 +1,272 @@
+package app
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"claude-monitor/internal/api"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
+	"claude-monitor/internal/utils"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// brailleBase is the code point of the empty braille pattern.
+	brailleBase = 0x2800
+	// axisLabelWidth reserves room for the "100%" y-axis labels.
+	axisLabelWidth = 4
+	// minChartPlotWidth is the narrowest plot drawn as a full chart; below it
+	// the view falls back to sparklines.
+	minChartPlotWidth = 24
+	// minChartHeight and maxChartHeight bound the plot height in rows.
+	minChartHeight = 4
+	maxChartHeight = 20
+)
+
+// brailleDots maps a sub-cell (x in 0..1, y in 0..3 from the top) to its dot bit.
+var brailleDots = [2][4]rune{
+	{0x01, 0x02, 0x04, 0x40},
+	{0x08, 0x10, 0x20, 0x80},
+}
+
+// sparkRunes are the levels used by the narrow-terminal sparkline fallback.
+var sparkRunes = []rune("▁▂▃▄▅▆▇█")
+
+// chartSeries is one utilization line to plot.
+type chartSeries struct {
+	label string
+	style lipgloss.Style
+	pick  func(api.UsageResponse) *api.WindowUsage
+}
+
+// chartSpec describes the time range and plot size for renderChart.
+type chartSpec struct {
+	from, to time.Time
+	width    int
+	height   int
+}
+
+// historySeries returns the plotted series in draw order; later series win
+// when both occupy the same cell.
+func historySeries() []chartSeries {
+	return []chartSeries{
+		{label: consts.LabelWeekly, style: chartWeeklyStyle, pick: func(u api.UsageResponse) *api.WindowUsage { return u.SevenDay }},
+		{label: consts.LabelCurrent, style: chartCurrentStyle, pick: func(u api.UsageResponse) *api.WindowUsage { return u.FiveHour }},
+	}
+}
+
+// renderChart draws a braille line chart of the series between spec.from and
+// spec.to, with y-axis labels, an x-axis with time labels, and reset
+// boundaries marked as dotted columns.
+//
+// Parameters:
+//   - samples: recorded samples sorted by time.
+//   - series: lines to draw.
+//   - spec: time range and total size (including axes) in cells.
+//
+// Returns:
+//   - rendered multi-line chart, or a sparkline fallback when too narrow.
+func renderChart(samples []store.Sample, series []chartSeries, spec chartSpec) string {
+	plotW := spec.width - axisLabelWidth - 1
+	plotH := int(utils.Clamp(float64(spec.height), minChartHeight, maxChartHeight))
+	if plotW < minChartPlotWidth || !spec.to.After(spec.from) {
+		return renderSparklines(samples, series, spec.from, spec.to, utils.Max(spec.width, 8))
+	}
+
+	subW, subH := plotW*2, plotH*4
+	owner := make([][]int, plotH)
+	bits := make([][]rune, plotH)
+	for r := range bits {
+		bits[r] = make([]rune, plotW)
+		owner[r] = make([]int, plotW)
+		for c := range owner[r] {
+			owner[r][c] = -1
+		}
+	}
+
+	for si, s := range series {
+		values := bucketMax(samples, s.pick, spec.from, spec.to, subW)
+		prevY := -1
+		for x, v := range values {
+			if math.IsNaN(v) {
+				prevY = -1
+				continue
+			}
+			y := int(math.Round((1 - utils.Clamp(v, 0, 100)/100) * float64(subH-1)))
+			lo, hi := y, y
+			if prevY >= 0 {
+				lo, hi = utils.Min(prevY, y), utils.Max(prevY, y)
+			}
+			for yy := lo; yy <= hi; yy++ {
+				r, c := yy/4, x/2
+				bits[r][c] |= brailleDots[x%2][yy%4]
+				owner[r][c] = si
+			}
+			prevY = y
+		}
+	}
+
+	resets := resetColumns(samples, spec.from, spec.to, plotW)
+
+	lines := make([]string, 0, plotH+2)
+	for r := 0; r < plotH; r++ {
+		var row strings.Builder
+		for c := 0; c < plotW; c++ {
+			switch {
+			case bits[r][c] != 0:
+				row.WriteString(series[owner[r][c]].style.Render(string(brailleBase + bits[r][c])))
+			case resets[c]:
+				row.WriteString(chartResetStyle.Render("┊"))
+			default:
+				row.WriteByte(' ')
+			}
+		}
+		lines = append(lines, chartAxisStyle.Render(axisLabel(r, plotH))+row.String())
+	}
+
+	lines = append(lines, chartAxisStyle.Render(strings.Repeat(" ", axisLabelWidth)+"└"+strings.Repeat("─", plotW)))
+	lines = append(lines, chartAxisStyle.Render(strings.Repeat(" ", axisLabelWidth+1)+timeAxis(spec.from, spec.to, plotW)))
+	return strings.Join(lines, "\n")
+}
+
+// bucketMax splits [from, to) into n equal buckets and returns the highest
+// utilization seen in each, NaN for buckets without samples.
+func bucketMax(samples []store.Sample, pick func(api.UsageResponse) *api.WindowUsage, from, to time.Time, n int) []float64 {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = math.NaN()
+	}
+	span := to.Sub(from)
+	if span <= 0 || n <= 0 {
+		return values
+	}
+	for _, s := range samples {
+		if s.Time.Before(from) || !s.Time.Before(to) {
+			continue
+		}
+		w := pick(s.UsageResponse)
+		if w == nil || w.Utilization == nil {
+			continue
+		}
+		i := int(float64(s.Time.Sub(from)) / float64(span) * float64(n))
+		if i >= n {
+			i = n - 1
+		}
+		if math.IsNaN(values[i]) || *w.Utilization > values[i] {
+			values[i] = *w.Utilization
+		}
+	}
+	return values
+}
+
+// resetColumns marks plot columns containing a window reset boundary reported
+// by any sample in range.
+func resetColumns(samples []store.Sample, from, to time.Time, width int) []bool {
+	cols := make([]bool, width)
+	span := to.Sub(from)
+	seen := map[int64]bool{}
+	for _, s := range samples {
+		for _, w := range []*api.WindowUsage{s.FiveHour, s.SevenDay} {
+			if w == nil || w.ResetsAt == nil {
+				continue
+			}
+			at := w.ResetsAt.Round(time.Minute)
+			// Future boundaries are skipped so they don't clutter the live edge.
+			if seen[at.Unix()] || at.Before(from) || !at.Before(to) || at.After(time.Now()) {
+				continue
+			}
+			seen[at.Unix()] = true
+			c := int(float64(at.Sub(from)) / float64(span) * float64(width))
+			if c >= 0 && c < width {
+				cols[c] = true
+			}
+		}
+	}
+	return cols
+}
+
+// axisLabel returns the y-axis label and tick for plot row r; unlabeled rows
+// get a plain axis line.
+func axisLabel(r, height int) string {
+	label := ""
+	switch r {
+	case 0:
+		label = "100%"
+	case (height - 1) / 2:
+		label = "50%"
+	case height - 1:
+		label = "0%"
+	}
+	if label == "" {
+		return strings.Repeat(" ", axisLabelWidth) + "│"
+	}
+	return lipgloss.PlaceHorizontal(axisLabelWidth, lipgloss.Right, label) + "┤"
+}
+
+// timeAxis lays out start, middle, and end time labels across width cells.
+func timeAxis(from, to time.Time, width int) string {
+	layout := consts.ChartTimeLayout
+	if to.Sub(from) > 24*time.Hour {
+		layout = consts.ChartDateLayout
+	}
+	left := utils.FormatTime(from.In(time.Local), layout)
+	mid := utils.FormatTime(from.Add(to.Sub(from)/2).In(time.Local), layout)
+	right := utils.FormatTime(to.In(time.Local), layout)
+
+	lw, mw, rw := lipgloss.Width(left), lipgloss.Width(mid), lipgloss.Width(right)
+	if lw+rw+1 > width {
+		return truncateWidth(left, width)
+	}
+	leftGap := width/2 - mw/2 - lw
+	rightGap := width - lw - leftGap - mw - rw
+	if leftGap < 1 || rightGap < 1 {
+		return left + strings.Repeat(" ", width-lw-rw) + right
+	}
+	return left + strings.Repeat(" ", leftGap) + mid + strings.Repeat(" ", rightGap) + right
+}
+
+// renderSparklines is the narrow-terminal fallback: one labeled sparkline
+// per series.
+func renderSparklines(samples []store.Sample, series []chartSeries, from, to time.Time, width int) string {
+	labelW := longestSeriesLabel(series) + 1
+	sparkW := utils.Max(width-labelW, 1)
+	lines := make([]string, 0, len(series))
+	for i := len(series) - 1; i >= 0; i-- {
+		s := series[i]
+		values := bucketMax(samples, s.pick, from, to, sparkW)
+		var b strings.Builder
+		for _, v := range values {
+			if math.IsNaN(v) {
+				b.WriteByte(' ')
+				continue
+			}
+			idx := int(math.Round(utils.Clamp(v, 0, 100) / 100 * float64(len(sparkRunes)-1)))
+			b.WriteRune(sparkRunes[idx])
+		}
+		label := lipgloss.NewStyle().Width(labelW).Render(truncateWidth(s.label, labelW-1))
+		lines = append(lines, label+s.style.Render(b.String()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// longestSeriesLabel returns the widest series label.
+func longestSeriesLabel(series []chartSeries) int {
+	w := 0
+	for _, s := range series {
+		w = utils.Max(w, lipgloss.Width(s.label))
+	}
+	return w
+}
+
+// renderLegend lists the series colors and the reset marker.
+func renderLegend(series []chartSeries) string {
+	parts := make([]string, 0, len(series)+1)
+	for i := len(series) - 1; i >= 0; i-- {
+		parts = append(parts, series[i].style.Render("●")+" "+helpDescStyle.Render(series[i].label))
+	}
+	parts = append(parts, chartResetStyle.Render("┊")+" "+helpDescStyle.Render(consts.TextChartResetLegend))
+	return strings.Join(parts, "  ")
+}
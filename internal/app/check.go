@@ -0,0 +1,198 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/report"
+	"claude-monitor/internal/utils"
+)
+
+// Nagios plugin exit codes.
+const (
+	CheckOK       = 0
+	CheckWarning  = 1
+	CheckCritical = 2
+	CheckUnknown  = 3
+)
+
+// checkStatusNames maps exit codes to the plugin status words.
+var checkStatusNames = map[int]string{
+	CheckOK:       "OK",
+	CheckWarning:  "WARNING",
+	CheckCritical: "CRITICAL",
+	CheckUnknown:  "UNKNOWN",
+}
+
+// CheckThresholds holds warning and critical utilization percentages keyed by
+// window (consts.WindowFiveHour, consts.WindowSevenDay).
+type CheckThresholds struct {
+	Warn map[string]float64
+	Crit map[string]float64
+}
+
+// CheckResult is the outcome of a single check run.
+type CheckResult struct {
+	// Code is the plugin exit code (CheckOK … CheckUnknown).
+	Code int
+	// Line is the summary with perfdata, e.g.
+	// "CLAUDE USAGE OK - 5h 42.0%, 7d 18.0% | five_hour=42.0%;80;95;0;100 …".
+	Line string
+}
+
+// ParseCheckThreshold parses a -warn/-crit value into per-window thresholds.
+// A bare number applies to every window; "window=value" pairs override one
+// window, e.g. "80", "five_hour=70,seven_day=90" or "80,seven_day=90".
+// Windows the spec does not mention keep def.
+//
+// Parameters:
+//   - spec: flag value.
+//   - def: threshold for windows not covered by spec.
+//
+// Returns:
+//   - thresholds for every known window.
+//   - error for malformed numbers, unknown windows, or values outside 0–100.
+func ParseCheckThreshold(spec string, def float64) (map[string]float64, error) {
+	out := map[string]float64{}
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key, raw, scoped := strings.Cut(part, "=")
+		if !scoped {
+			raw = key
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(raw, "%")), 64)
+		if err != nil || v < 0 || v > 100 {
+			return nil, fmt.Errorf(consts.ErrCheckThresholdFmt, part)
+		}
+		if !scoped {
+			def = v
+			continue
+		}
+		if !knownWindow(strings.TrimSpace(key)) {
+			return nil, fmt.Errorf(consts.ErrWindowFmt, key)
+		}
+		out[strings.TrimSpace(key)] = v
+	}
+	for _, w := range report.Windows {
+		if _, set := out[w.Key]; !set {
+			out[w.Key] = def
+		}
+	}
+	return out, nil
+}
+
+// Validate checks that no window's warning threshold exceeds its critical one.
+//
+// Returns:
+//   - nil when thresholds are ordered.
+//   - error naming the first misordered window.
+func (t CheckThresholds) Validate() error {
+	for _, w := range report.Windows {
+		if t.Warn[w.Key] > t.Crit[w.Key] {
+			return fmt.Errorf(consts.ErrCheckThresholdOrderFmt, w.Key, t.Warn[w.Key], t.Crit[w.Key])
+		}
+	}
+	return nil
+}
+
+// knownWindow reports whether key names a usage window.
+func knownWindow(key string) bool {
+	for _, w := range report.Windows {
+		if w.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Check fetches usage once and classifies it against the thresholds. API and
+// transport failures, including timeouts, map to CheckUnknown with the same
+// message the TUI shows.
+//
+// Parameters:
+//   - ctx: parent context; the request uses the configured timeout.
+//   - cfg: validated Config.
+//   - th: warning and critical thresholds per window.
+//
+// Returns:
+//   - exit code and single-line summary with perfdata.
+func Check(ctx context.Context, cfg Config, th CheckThresholds) CheckResult {
+	reqCtx, cancel := requestContext(ctx, cfg)
+	defer cancel()
+	data, _, err := fetchAndPersist(reqCtx, cfg)
+	if err != nil {
+		return CheckUnknownResult(formatError(err))
+	}
+
+	code := CheckOK
+	summary := make([]string, 0, len(report.Windows))
+	perf := make([]string, 0, len(report.Windows))
+	for _, win := range report.Windows {
+		u := win.Pick(data)
+		if u == nil || u.Utilization == nil {
+			continue
+		}
+		pct := *u.Utilization
+		warn, crit := th.Warn[win.Key], th.Crit[win.Key]
+
+		state := CheckOK
+		switch {
+		case pct >= crit:
+			state = CheckCritical
+		case pct >= warn:
+			state = CheckWarning
+		}
+		if state > code {
+			code = state
+		}
+
+		label := barLabel(win.Key)
+		text := fmt.Sprintf(consts.CheckWindowFmt, label, pct)
+		if state != CheckOK {
+			text += fmt.Sprintf(consts.CheckOverFmt, checkStatusNames[state])
+		}
+		if u.ResetsAt != nil {
+			text += " (" + utils.FormatRemainAt(*u.ResetsAt, time.Now()) + ")"
+		}
+		summary = append(summary, text)
+		perf = append(perf, fmt.Sprintf(consts.CheckPerfFmt, win.Key, pct, fmtThreshold(warn), fmtThreshold(crit)))
+	}
+	if len(summary) == 0 {
+		return CheckUnknownResult(consts.TextNoData)
+	}
+
+	line := fmt.Sprintf(consts.CheckLineFmt, checkStatusNames[code], strings.Join(summary, ", "))
+	return CheckResult{Code: code, Line: line + " | " + strings.Join(perf, " ")}
+}
+
+// CheckUnknownResult builds an UNKNOWN result for failures that happen before
+// or during the fetch (for example token resolution).
+//
+// Parameters:
+//   - msg: human-readable reason.
+//
+// Returns:
+//   - result with CheckUnknown and a formatted summary line.
+func CheckUnknownResult(msg string) CheckResult {
+	return CheckResult{Code: CheckUnknown, Line: fmt.Sprintf(consts.CheckLineFmt, checkStatusNames[CheckUnknown], msg)}
+}
+
+// barLabel returns the short status-bar label for a window key.
+func barLabel(key string) string {
+	if key == consts.WindowSevenDay {
+		return consts.BarLabelSevenDay
+	}
+	return consts.BarLabelFiveHour
+}
+
+// fmtThreshold renders a threshold without trailing zeros for perfdata.
+func fmtThreshold(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
+	"claude-monitor/internal/theme"
 )
 
 // Config holds runtime options for the TUI.
@@ -15,14 +17,54 @@ type Config struct {
 	Token string
 	// Credentials is the path to the credentials file (for diagnostics).
 	Credentials string
-	// RefreshEvery controls the polling interval for usage fetches.
+	// RefreshEvery is the base polling interval; the scheduler speeds up or
+	// slows down around it.
 	RefreshEvery time.Duration
+	// MinRefresh and MaxRefresh bound the scheduler; zero derives them from
+	// RefreshEvery (a quarter and eight times, respectively).
+	MinRefresh time.Duration
+	MaxRefresh time.Duration
+	// Threshold is the utilization percentage treated as "near the limit";
+	// zero uses the default of 80.
+	Threshold float64
 	// HTTPClient executes API requests; its Timeout is also used for per-call deadlines.
 	HTTPClient *http.Client
 	// BetaHeader carries the anthropic-beta header value required by the API.
 	BetaHeader string
+	// BetaCandidates are fallback beta values tried in order when the API
+	// rejects BetaHeader as outdated.
+	BetaCandidates []string
+	// BetaExplicit reports that BetaHeader came from -beta-header or
+	// ANTHROPIC_BETA_HEADER rather than the baked-in default, so it is tried
+	// before the remembered value.
+	BetaExplicit bool
+	// BetaState remembers the beta value that last worked; nil disables it.
+	BetaState *store.BetaFile
+	// Samples records every successful fetch; nil disables recording.
+	Samples *store.SampleLog
+	// Snapshot keeps the last good fetch for instant start and offline use;
+	// nil disables it.
+	Snapshot *store.SnapshotFile
+	// Bands colors bars and values by severity; the zero value keeps the
+	// single accent color.
+	Bands Bands
+	// Themes is the theme cycle; the first is applied at start. Empty keeps
+	// the default theme and disables cycling.
+	Themes []theme.Theme
+	// Layout picks the full, compact, or minimal dashboard; the zero value
+	// chooses by window height.
+	Layout LayoutMode
+	// Keys overrides the TUI bindings; nil uses DefaultKeyMap.
+	Keys *KeyMap
+	// NoMouse leaves the mouse to the terminal so text can be selected;
+	// rows, the footer, and the wheel stop reacting to it.
+	NoMouse bool
 }
 
+// defaultThreshold is the utilization percentage treated as "near the limit"
+// when Config.Threshold is unset.
+const defaultThreshold = 80.0
+
 // Validate ensures the configuration is usable before running the UI.
 //
 // Parameters:
@@ -49,7 +91,19 @@ func (c Config) Validate() error {
 		return fmt.Errorf(consts.ErrRefreshInterval)
 	}
 	if c.RefreshEvery < minRefresh {
-		return fmt.Errorf("refresh interval too small; must be at least %s", minRefresh)
+		return fmt.Errorf(consts.ErrRefreshTooSmallFmt, minRefresh)
+	}
+	if c.MinRefresh < 0 || c.MaxRefresh < 0 {
+		return fmt.Errorf(consts.ErrRefreshBounds)
+	}
+	if c.MinRefresh > 0 && c.MinRefresh < minRefresh {
+		return fmt.Errorf(consts.ErrMinRefreshTooSmallFmt, minRefresh)
+	}
+	if c.MinRefresh > 0 && c.MaxRefresh > 0 && c.MinRefresh > c.MaxRefresh {
+		return fmt.Errorf(consts.ErrRefreshBounds)
+	}
+	if c.Threshold < 0 || c.Threshold > 100 {
+		return fmt.Errorf(consts.ErrThresholdRange)
 	}
 	return nil
 }
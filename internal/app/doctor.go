@@ -0,0 +1,84 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/doctor"
+	"claude-monitor/internal/utils"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// doctorMarks maps check statuses to their checklist glyphs.
+var doctorMarks = map[doctor.Status]string{
+	doctor.StatusOK:   "✓",
+	doctor.StatusWarn: "!",
+	doctor.StatusFail: "✗",
+	doctor.StatusSkip: "–",
+}
+
+// RenderDoctor formats a doctor report as a checklist or JSON.
+//
+// Parameters:
+//   - rep: report from doctor.Run.
+//   - format: consts.FormatText or consts.FormatJSON.
+//
+// Returns:
+//   - rendered output ending in a newline.
+//   - error for unknown formats.
+func RenderDoctor(rep doctor.Report, format string) (string, error) {
+	switch format {
+	case consts.FormatText:
+		return renderDoctorText(rep), nil
+	case consts.FormatJSON:
+		out, err := json.MarshalIndent(rep, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(out) + "\n", nil
+	}
+	return "", fmt.Errorf(consts.ErrFormatFmt, format)
+}
+
+// renderDoctorText renders one line per check with an indented hint under
+// each warning or failure, followed by a summary.
+func renderDoctorText(rep doctor.Report) string {
+	nameWidth := 0
+	for _, c := range rep.Checks {
+		nameWidth = utils.Max(nameWidth, lipgloss.Width(c.Label()))
+	}
+
+	var b strings.Builder
+	counts := map[doctor.Status]int{}
+	for _, c := range rep.Checks {
+		counts[c.Status]++
+		mark := doctorMarkStyle(c.Status).Render(doctorMarks[c.Status])
+		label := c.Label()
+		name := labelBaseStyle.Render(label + strings.Repeat(" ", nameWidth-lipgloss.Width(label)))
+		fmt.Fprintf(&b, "%s %s  %s\n", mark, name, c.Detail)
+		if c.Hint != "" && c.Status != doctor.StatusOK {
+			fmt.Fprintf(&b, "  %s  %s\n", strings.Repeat(" ", nameWidth), statusStyle.Render("→ "+c.Hint))
+		}
+	}
+	b.WriteString("\n")
+	b.WriteString(statusStyle.Render(fmt.Sprintf(consts.TextDoctorSummaryFmt,
+		counts[doctor.StatusOK], consts.TextDoctorWarnings.Format(counts[doctor.StatusWarn]), counts[doctor.StatusFail])))
+	b.WriteString("\n")
+	return b.String()
+}
+
+// doctorMarkStyle colors a status glyph.
+func doctorMarkStyle(s doctor.Status) lipgloss.Style {
+	switch s {
+	case doctor.StatusOK:
+		return lipgloss.NewStyle().Foreground(paletteAccent)
+	case doctor.StatusWarn:
+		return lipgloss.NewStyle().Foreground(paletteAccentHi)
+	case doctor.StatusFail:
+		return lipgloss.NewStyle().Foreground(paletteError).Bold(true)
+	}
+	return lipgloss.NewStyle().Foreground(paletteMuted)
+}
@@ -0,0 +1,279 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"claude-monitor/internal/api"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
+	"claude-monitor/internal/utils"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// eventKind classifies an event log entry and picks its marker and color.
+type eventKind int
+
+const (
+	eventFetch eventKind = iota
+	eventError
+	eventBackoff
+	eventReset
+	eventCrossUp
+	eventCrossDown
+)
+
+// event is one timestamped entry in the event log.
+type event struct {
+	at   time.Time
+	kind eventKind
+	text string
+}
+
+// maxEvents bounds the event log; the oldest entries are dropped first.
+const maxEvents = 500
+
+// eventsChrome is the number of rows the event screen spends outside the
+// viewport (page padding, header, title, box border and padding, footer).
+const eventsChrome = 14
+
+// defaultEventsHeight is used until the terminal height is known.
+const defaultEventsHeight = 10
+
+// logEvents appends events, trims the log, and refreshes the viewport.
+func (m *model) logEvents(events ...event) {
+	m.events = append(m.events, events...)
+	if drop := len(m.events) - maxEvents; drop > 0 {
+		m.events = append([]event(nil), m.events[drop:]...)
+	}
+	m.syncEvents()
+}
+
+// fetchEvents describes a successful fetch: the fetch itself, then any
+// window that reset or crossed the threshold since prev.
+//
+// Parameters:
+//   - at: fetch completion time.
+//   - latency: request duration.
+//   - prev: previously shown usage, or nil.
+//   - cur: fetched usage.
+//   - threshold: "near the limit" percentage; zero uses the default.
+//
+// Returns:
+//   - events in display order.
+func fetchEvents(at time.Time, latency time.Duration, prev *api.UsageResponse, cur api.UsageResponse, threshold float64) []event {
+	if threshold <= 0 {
+		threshold = defaultThreshold
+	}
+	events := []event{{at: at, kind: eventFetch, text: fmt.Sprintf(consts.TextEventFetchFmt, latencyText(latency), usageSummary(cur, at, barOptions{}))}}
+	if prev == nil {
+		return events
+	}
+	windows := []struct {
+		label     string
+		prev, cur *api.WindowUsage
+	}{
+		{consts.BarLabelFiveHour, prev.FiveHour, cur.FiveHour},
+		{consts.BarLabelSevenDay, prev.SevenDay, cur.SevenDay},
+	}
+	for _, w := range windows {
+		if w.prev == nil || w.cur == nil || w.prev.Utilization == nil || w.cur.Utilization == nil {
+			continue
+		}
+		was, now := *w.prev.Utilization, *w.cur.Utilization
+		if w.prev.ResetsAt != nil && w.cur.ResetsAt != nil &&
+			w.cur.ResetsAt.After(*w.prev.ResetsAt) && !at.Before(*w.prev.ResetsAt) {
+			events = append(events, event{at: at, kind: eventReset, text: fmt.Sprintf(consts.TextEventResetFmt, w.label, was)})
+		}
+		switch {
+		case was < threshold && now >= threshold:
+			events = append(events, event{at: at, kind: eventCrossUp, text: fmt.Sprintf(consts.TextEventCrossUpFmt, w.label, threshold, now)})
+		case was >= threshold && now < threshold:
+			events = append(events, event{at: at, kind: eventCrossDown, text: fmt.Sprintf(consts.TextEventCrossDownFmt, w.label, threshold, now)})
+		}
+	}
+	return events
+}
+
+// errorEvents describes a failed fetch and the backoff chosen after it.
+//
+// Parameters:
+//   - at: failure time.
+//   - latency: request duration.
+//   - err: fetch error.
+//   - failures: consecutive failures including this one.
+//   - backoff: delay before the retry; zero when polling is paused.
+//
+// Returns:
+//   - events in display order.
+func errorEvents(at time.Time, latency time.Duration, err error, failures int, backoff time.Duration) []event {
+	text := fmt.Sprintf(consts.TextEventErrorFmt, latencyText(latency), formatError(err))
+	var httpErr api.HTTPError
+	if errors.As(err, &httpErr) && httpErr.RetryAfter > 0 {
+		text += fmt.Sprintf(consts.TextEventRetryAfterFmt, shortDuration(httpErr.RetryAfter))
+	}
+	events := []event{{at: at, kind: eventError, text: text}}
+	if backoff > 0 {
+		events = append(events, event{at: at, kind: eventBackoff,
+			text: fmt.Sprintf(consts.TextEventBackoffFmt, shortDuration(backoff.Round(time.Second)), failures)})
+	}
+	return events
+}
+
+// latencyText rounds a request duration for display.
+func latencyText(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(time.Millisecond).String()
+	}
+	return d.Round(10 * time.Millisecond).String()
+}
+
+// usageSummary formats each window as "5h 42%", joined by dots; with
+// opt.remaining each window also shows the time until it resets.
+func usageSummary(u api.UsageResponse, at time.Time, opt barOptions) string {
+	segments, _ := barSegments(store.Sample{Time: at, UsageResponse: u}, at, opt)
+	texts := make([]string, 0, len(segments))
+	for _, s := range segments {
+		texts = append(texts, s.text)
+	}
+	if len(texts) == 0 {
+		return consts.TextNoData
+	}
+	return strings.Join(texts, consts.TextSeparatorDot)
+}
+
+// toggleEvents switches between the dashboard and the event log.
+func (m model) toggleEvents() (tea.Model, tea.Cmd) {
+	if m.view == viewEvents {
+		m.view = viewDashboard
+		return m, nil
+	}
+	m.view = viewEvents
+	m.syncEvents()
+	m.eventsVP.GotoBottom()
+	return m, nil
+}
+
+// handleEventsKey scrolls the event log.
+//
+// Returns:
+//   - the updated model and true when the key was consumed.
+func (m model) handleEventsKey(msg tea.KeyMsg) (model, bool) {
+	k := m.keys
+	switch {
+	case key.Matches(msg, k.Top):
+		m.eventsVP.GotoTop()
+	case key.Matches(msg, k.Bottom):
+		m.eventsVP.GotoBottom()
+	case key.Matches(msg, k.ScrollUp, k.ScrollDown, k.PageUp, k.PageDown):
+		m.eventsVP, _ = m.eventsVP.Update(msg)
+	default:
+		return m, false
+	}
+	return m, true
+}
+
+// syncEvents sizes the viewport to the window and re-renders the log,
+// staying pinned to the newest entry when it was already at the bottom.
+func (m *model) syncEvents() {
+	const chartFrame = 6
+	frame := newLayout(m.width)
+	width := utils.Max(4, utils.Max(12, frame.contentWidth-2)-chartFrame)
+	height := defaultEventsHeight
+	if m.height > 0 {
+		height = utils.Max(3, m.height-eventsChrome)
+	}
+	follow := m.eventsVP.AtBottom()
+	m.eventsVP.Width = width
+	m.eventsVP.Height = height
+	m.eventsVP.SetContent(renderEventLines(m.events, width))
+	if follow {
+		m.eventsVP.GotoBottom()
+	}
+}
+
+// newEventsViewport builds the log viewport scrolled by km.
+func newEventsViewport(km KeyMap) viewport.Model {
+	vp := viewport.New(0, defaultEventsHeight)
+	vp.KeyMap = viewport.KeyMap{
+		Up:           km.ScrollUp,
+		Down:         km.ScrollDown,
+		PageUp:       km.PageUp,
+		PageDown:     km.PageDown,
+		HalfPageUp:   key.NewBinding(key.WithDisabled()),
+		HalfPageDown: key.NewBinding(key.WithDisabled()),
+	}
+	return vp
+}
+
+// renderEventLines draws one line per event, oldest first.
+//
+// Parameters:
+//
+//	events - log entries.
+//	width  - line width; longer texts are cut.
+//
+// Returns:
+//
+//	string - newline-joined lines.
+func renderEventLines(events []event, width int) string {
+	lines := make([]string, 0, len(events))
+	for _, e := range events {
+		mark, color := eventMarker(e.kind)
+		stamp := e.at.In(time.Local).Format(consts.EventTimeLayout)
+		prefix := stamp + " " + mark + " "
+		text := truncateWidth(e.text, width-lipgloss.Width(prefix))
+		lines = append(lines, statusStyle.Render(stamp)+" "+
+			lipgloss.NewStyle().Foreground(color).Render(mark)+" "+
+			lipgloss.NewStyle().Foreground(paletteText).Render(text))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// eventMarker returns the glyph and color of an event kind.
+func eventMarker(kind eventKind) (string, lipgloss.TerminalColor) {
+	switch kind {
+	case eventError:
+		return consts.EventMarkError, paletteError
+	case eventBackoff:
+		return consts.EventMarkBackoff, paletteMuted
+	case eventReset:
+		return consts.EventMarkReset, paletteAccent
+	case eventCrossUp:
+		return consts.EventMarkCrossUp, paletteWarn
+	case eventCrossDown:
+		return consts.EventMarkCrossDown, paletteOK
+	}
+	return consts.EventMarkFetch, paletteOK
+}
+
+// renderEvents draws the event log screen: title and the scrollable log.
+//
+// Parameters:
+//
+//	frame - layout sizing constraints.
+//	m     - current model with the log and its viewport.
+//
+// Returns:
+//
+//	string - rendered body content.
+func renderEvents(frame layout, m model) string {
+	title := labelBaseStyle.Render(consts.TextEventsTitle)
+	if n := len(m.events); n > 0 {
+		title += statusStyle.Render(consts.TextSeparatorDot + fmt.Sprintf(consts.TextEventsCountFmt, n))
+	}
+	chartWidth := utils.Max(12, frame.contentWidth-2)
+	content := statusStyle.Render(consts.TextEventsEmpty)
+	if len(m.events) > 0 {
+		content = m.eventsVP.View()
+	}
+	return lipgloss.JoinVertical(lipgloss.Left,
+		title,
+		chartBoxStyle.Width(chartWidth).Render(content))
+}
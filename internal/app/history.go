@@ -0,0 +1,238 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
+	"claude-monitor/internal/utils"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// viewMode selects which screen the body renders.
+type viewMode int
+
+const (
+	viewDashboard viewMode = iota
+	viewHistory
+	viewEvents
+)
+
+// historyRanges are the selectable chart spans, narrowest first.
+var historyRanges = []struct {
+	label string
+	span  time.Duration
+}{
+	{label: "5h", span: 5 * time.Hour},
+	{label: "24h", span: 24 * time.Hour},
+	{label: "7d", span: 7 * 24 * time.Hour},
+	{label: "30d", span: 30 * 24 * time.Hour},
+}
+
+// historyChrome is the number of rows the history screen spends outside the
+// plot itself (page padding, header, box border and padding, title, legend,
+// axes, footer) and is subtracted from the window height.
+const historyChrome = 19
+
+// defaultHistoryHeight is used until the terminal height is known.
+const defaultHistoryHeight = 10
+
+// historyView holds state for the full-screen history chart.
+type historyView struct {
+	samples  []store.Sample
+	loaded   bool
+	loading  bool
+	err      error
+	rangeIdx int
+	// offset pans the visible range back from now.
+	offset time.Duration
+}
+
+// historyMsg carries samples loaded from the sample log.
+type historyMsg struct {
+	samples []store.Sample
+	err     error
+}
+
+// loadHistoryCmd reads the longest selectable range from the sample log.
+//
+// Params:
+//   - log: sample log to read.
+//
+// Returns:
+//   - a command that emits historyMsg.
+func loadHistoryCmd(log *store.SampleLog) tea.Cmd {
+	return func() tea.Msg {
+		span := historyRanges[len(historyRanges)-1].span
+		samples, err := log.Read(time.Now().Add(-span), time.Time{})
+		return historyMsg{samples: samples, err: err}
+	}
+}
+
+// toggleHistory switches between the dashboard and the history chart,
+// loading recorded samples the first time the chart is opened.
+func (m model) toggleHistory() (tea.Model, tea.Cmd) {
+	if m.view == viewHistory {
+		m.view = viewDashboard
+		return m, nil
+	}
+	m.view = viewHistory
+	if m.cfg.Samples == nil || m.history.loaded || m.history.loading {
+		return m, nil
+	}
+	m.history.loading = true
+	return m, loadHistoryCmd(m.cfg.Samples)
+}
+
+// handleHistory stores loaded samples, keeping any live samples that arrived
+// while the log was being read.
+func (m model) handleHistory(msg historyMsg) (tea.Model, tea.Cmd) {
+	m.history.loading = false
+	m.history.err = msg.err
+	if msg.err != nil {
+		return m, nil
+	}
+	live := m.history.samples
+	m.history.samples = msg.samples
+	for _, s := range live {
+		if n := len(m.history.samples); n == 0 || s.Time.After(m.history.samples[n-1].Time) {
+			m.history.samples = append(m.history.samples, s)
+		}
+	}
+	m.history.loaded = true
+	return m, nil
+}
+
+// recordHistory appends a freshly fetched sample to the in-memory history
+// and drops samples older than the longest range.
+func (m *model) recordHistory(s store.Sample) {
+	if m.cfg.Samples == nil {
+		return
+	}
+	m.history.samples = append(m.history.samples, s)
+	cutoff := s.Time.Add(-historyRanges[len(historyRanges)-1].span)
+	drop := 0
+	for drop < len(m.history.samples) && m.history.samples[drop].Time.Before(cutoff) {
+		drop++
+	}
+	if drop > 0 {
+		m.history.samples = append([]store.Sample(nil), m.history.samples[drop:]...)
+	}
+}
+
+// handleHistoryKey processes range, zoom, and pan keys while the chart is
+// shown.
+//
+// Returns:
+//   - the updated model and true when the key was consumed.
+func (m model) handleHistoryKey(msg tea.KeyMsg) (model, bool) {
+	h := &m.history
+	k := m.keys
+	span := historyRanges[h.rangeIdx].span
+	for i, b := range k.Range {
+		if key.Matches(msg, b) {
+			h.rangeIdx = i
+			return m, true
+		}
+	}
+	switch {
+	case key.Matches(msg, k.ZoomIn):
+		if h.rangeIdx > 0 {
+			h.rangeIdx--
+		}
+	case key.Matches(msg, k.ZoomOut):
+		if h.rangeIdx < len(historyRanges)-1 {
+			h.rangeIdx++
+		}
+	case key.Matches(msg, k.PanLeft):
+		h.offset += span / 4
+		if limit := historyRanges[len(historyRanges)-1].span; h.offset > limit {
+			h.offset = limit
+		}
+	case key.Matches(msg, k.PanRight):
+		h.offset -= span / 4
+		if h.offset < 0 {
+			h.offset = 0
+		}
+	case key.Matches(msg, k.Live):
+		h.offset = 0
+	default:
+		return m, false
+	}
+	return m, true
+}
+
+// renderHistory draws the history screen: range selector, chart, and legend.
+//
+// Parameters:
+//
+//	frame - layout sizing constraints.
+//	m     - current model with history state.
+//
+// Returns:
+//
+//	string - rendered body content.
+func renderHistory(frame layout, m model) string {
+	h := m.history
+	title := renderRangeSelector(h.rangeIdx)
+	if h.offset > 0 {
+		end := utils.FormatTime(time.Now().Add(-h.offset).In(time.Local), consts.ReportHourLayout)
+		title += statusStyle.Render(consts.TextSeparatorDot + fmt.Sprintf(consts.TextHistoryEndingFmt, end))
+	}
+
+	const chartFrame = 6
+	chartWidth := utils.Max(12, frame.contentWidth-2)
+	innerWidth := utils.Max(4, chartWidth-chartFrame)
+
+	var content string
+	switch {
+	case m.cfg.Samples == nil:
+		content = statusStyle.Render(consts.TextHistoryDisabled)
+	case h.err != nil:
+		return errorBox(formatError(h.err), frame.contentWidth)
+	case h.loading:
+		content = statusStyle.Render(consts.TextHistoryLoading)
+	case len(h.samples) == 0:
+		content = statusStyle.Render(consts.TextHistoryEmpty)
+	default:
+		height := defaultHistoryHeight
+		if m.height > 0 {
+			height = m.height - historyChrome
+		}
+		to := time.Now().Add(-h.offset)
+		series := historySeries()
+		content = lipgloss.JoinVertical(lipgloss.Left,
+			renderChart(h.samples, series, chartSpec{
+				from:   to.Add(-historyRanges[h.rangeIdx].span),
+				to:     to,
+				width:  innerWidth,
+				height: height,
+			}),
+			"",
+			renderLegend(series),
+		)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		title,
+		chartBoxStyle.Width(chartWidth).Render(content))
+}
+
+// renderRangeSelector lists the selectable ranges, highlighting the active one.
+func renderRangeSelector(active int) string {
+	parts := make([]string, 0, len(historyRanges))
+	for i, r := range historyRanges {
+		label := fmt.Sprintf("%d %s", i+1, r.label)
+		if i == active {
+			parts = append(parts, helpKeyStyle.Render(label))
+		} else {
+			parts = append(parts, helpDescStyle.Render(label))
+		}
+	}
+	return labelBaseStyle.Render(consts.TextHistoryTitle) + "  " + strings.Join(parts, "  ")
+}
@@ -0,0 +1,370 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"claude-monitor/internal/consts"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// KeyMap holds every TUI binding. The help descriptions are the long ones
+// shown in the ? overlay; the footer relabels them with shorter text.
+type KeyMap struct {
+	Refresh key.Binding
+	History key.Binding
+	// Back leaves the history chart or the help overlay.
+	Back   key.Binding
+	Pause  key.Binding
+	Faster key.Binding
+	Slower key.Binding
+	Theme  key.Binding
+	Help   key.Binding
+	Quit   key.Binding
+	// Range selects historyRanges[i] on the history chart.
+	Range    [4]key.Binding
+	ZoomIn   key.Binding
+	ZoomOut  key.Binding
+	PanLeft  key.Binding
+	PanRight key.Binding
+	Live     key.Binding
+	Events   key.Binding
+	// ScrollUp through Bottom move through the event log.
+	ScrollUp   key.Binding
+	ScrollDown key.Binding
+	PageUp     key.Binding
+	PageDown   key.Binding
+	Top        key.Binding
+	Bottom     key.Binding
+}
+
+// keyScope says in which view an action is live; bindings only conflict
+// when their scopes overlap.
+type keyScope int
+
+const (
+	scopeGlobal keyScope = iota
+	scopeDashboard
+	scopeHistory
+	scopeEvents
+)
+
+// keyAction ties a keybinding file entry to its binding.
+type keyAction struct {
+	name    string
+	scope   keyScope
+	binding *key.Binding
+}
+
+// keySymbols replaces key names with the glyphs shown in help.
+var keySymbols = map[string]string{
+	consts.HistoryPanLeftKey:    consts.HistoryPanLeftSymbol,
+	consts.HistoryPanRightKey:   consts.HistoryPanRightSymbol,
+	consts.EventsScrollUpKey:    consts.EventsScrollUpSymbol,
+	consts.EventsScrollDownKey:  consts.EventsScrollDownSymbol,
+	consts.EventsPageDownAltKey: consts.EventsSpaceSymbol,
+}
+
+// DefaultKeyMap returns the built-in bindings.
+func DefaultKeyMap() KeyMap {
+	km := KeyMap{
+		Refresh:  newBinding(consts.HelpRefreshLongDesc, consts.HelpRefreshKey, consts.HelpRefreshKeyUpper),
+		History:  newBinding(consts.HelpHistoryLongDesc, consts.HelpHistoryKey),
+		Back:     newBinding(consts.HelpBackLongDesc, consts.HelpBackKey),
+		Pause:    newBinding(consts.HelpPauseLongDesc, consts.HelpPauseKey),
+		Faster:   newBinding(consts.HelpFasterDesc, consts.HelpFasterKey),
+		Slower:   newBinding(consts.HelpSlowerDesc, consts.HelpSlowerKey, consts.HelpSlowerAltKey),
+		Theme:    newBinding(consts.HelpThemeLongDesc, consts.HelpThemeKey),
+		Help:     newBinding(consts.HelpOverlayLongDesc, consts.HelpOverlayKey),
+		Quit:     newBinding(consts.HelpQuitLongDesc, consts.HelpQuitKey, consts.HelpQuitCtrlKey),
+		ZoomIn:   newBinding(consts.HelpZoomInDesc, consts.HistoryZoomInKey, consts.HistoryZoomInAltKey),
+		ZoomOut:  newBinding(consts.HelpZoomOutDesc, consts.HistoryZoomOutKey),
+		PanLeft:  newBinding(consts.HelpPanLeftDesc, consts.HistoryPanLeftKey),
+		PanRight: newBinding(consts.HelpPanRightDesc, consts.HistoryPanRightKey),
+		Live:     newBinding(consts.HelpLiveDesc, consts.HistoryLiveKey),
+		Events:   newBinding(consts.HelpEventsLongDesc, consts.HelpEventsKey),
+
+		ScrollUp:   newBinding(consts.HelpScrollUpDesc, consts.EventsScrollUpKey, consts.EventsScrollUpAltKey),
+		ScrollDown: newBinding(consts.HelpScrollDnDesc, consts.EventsScrollDownKey, consts.EventsScrollDownAltKey),
+		PageUp:     newBinding(consts.HelpPageUpDesc, consts.EventsPageUpKey, consts.EventsPageUpAltKey),
+		PageDown:   newBinding(consts.HelpPageDnDesc, consts.EventsPageDownKey, consts.EventsPageDownAltKey),
+		Top:        newBinding(consts.HelpTopDesc, consts.EventsTopKey),
+		Bottom:     newBinding(consts.HelpBottomDesc, consts.EventsBottomKey),
+	}
+	for i, k := range []string{consts.HistoryRange1Key, consts.HistoryRange2Key, consts.HistoryRange3Key, consts.HistoryRange4Key} {
+		km.Range[i] = newBinding(fmt.Sprintf(consts.HelpRangeFmt, historyRanges[i].label), k)
+	}
+	return km
+}
+
+// DefaultKeysPath returns the keybinding file read when -keys is not given.
+func DefaultKeysPath() string {
+	if dir, err := os.UserConfigDir(); err == nil {
+		return filepath.Join(dir, consts.AppDirName, consts.KeysFileName)
+	}
+	return filepath.Join("."+consts.AppDirName, consts.KeysFileName)
+}
+
+// LoadKeyMap reads a keybinding file over the defaults. The file is a JSON
+// object mapping action names to key lists, e.g. {"history": ["g"]}; an
+// empty list unbinds the action. A missing file keeps the defaults.
+//
+// Parameters:
+//   - path: keybinding file; empty keeps the defaults.
+//
+// Returns:
+//   - the merged key map.
+//   - error for unreadable files, unknown actions, or conflicting keys.
+func LoadKeyMap(path string) (KeyMap, error) {
+	km := DefaultKeyMap()
+	if strings.TrimSpace(path) == "" {
+		return km, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return km, nil
+	}
+	if err != nil {
+		return KeyMap{}, fmt.Errorf(consts.ErrKeysFileFmt, path, err)
+	}
+	var spec map[string][]string
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return KeyMap{}, fmt.Errorf(consts.ErrKeysFileFmt, path, err)
+	}
+	if err := km.remap(spec); err != nil {
+		return KeyMap{}, fmt.Errorf(consts.ErrKeysFileFmt, path, err)
+	}
+	return km, nil
+}
+
+// remap rebinds the actions named in spec and validates the result.
+func (km *KeyMap) remap(spec map[string][]string) error {
+	actions := km.actions()
+	byName := make(map[string]*key.Binding, len(actions))
+	names := make([]string, 0, len(actions))
+	for _, a := range actions {
+		byName[a.name] = a.binding
+		names = append(names, a.name)
+	}
+	requested := make([]string, 0, len(spec))
+	for name := range spec {
+		requested = append(requested, name)
+	}
+	sort.Strings(requested)
+	for _, name := range requested {
+		b, ok := byName[name]
+		if !ok {
+			return fmt.Errorf(consts.ErrKeysActionFmt, name, strings.Join(names, ", "))
+		}
+		keys := spec[name]
+		if len(keys) == 0 {
+			b.Unbind()
+			continue
+		}
+		for _, k := range keys {
+			if strings.TrimSpace(k) == "" {
+				return fmt.Errorf(consts.ErrKeysEmptyFmt, name)
+			}
+		}
+		b.SetKeys(keys...)
+		b.SetHelp(keyLabel(keys), b.Help().Desc)
+	}
+	return km.validate()
+}
+
+// validate rejects a map without a quit key or with a key bound to two
+// actions that are live in the same view.
+func (km *KeyMap) validate() error {
+	if len(km.Quit.Keys()) == 0 {
+		return errors.New(consts.ErrKeysQuitRequired)
+	}
+	for _, view := range []keyScope{scopeDashboard, scopeHistory, scopeEvents} {
+		owner := make(map[string]string)
+		for _, a := range km.actions() {
+			if a.scope != scopeGlobal && a.scope != view {
+				continue
+			}
+			for _, k := range a.binding.Keys() {
+				if prev, ok := owner[k]; ok {
+					return fmt.Errorf(consts.ErrKeysConflictFmt, k, prev, a.name)
+				}
+				owner[k] = a.name
+			}
+		}
+	}
+	return nil
+}
+
+// actions lists every remappable binding with its file name and scope.
+func (km *KeyMap) actions() []keyAction {
+	return []keyAction{
+		{consts.KeyActionRefresh, scopeGlobal, &km.Refresh},
+		{consts.KeyActionHistory, scopeGlobal, &km.History},
+		{consts.KeyActionBack, scopeGlobal, &km.Back},
+		{consts.KeyActionPause, scopeGlobal, &km.Pause},
+		{consts.KeyActionFaster, scopeDashboard, &km.Faster},
+		{consts.KeyActionSlower, scopeDashboard, &km.Slower},
+		{consts.KeyActionTheme, scopeGlobal, &km.Theme},
+		{consts.KeyActionHelp, scopeGlobal, &km.Help},
+		{consts.KeyActionQuit, scopeGlobal, &km.Quit},
+		{consts.KeyActionRange1, scopeHistory, &km.Range[0]},
+		{consts.KeyActionRange2, scopeHistory, &km.Range[1]},
+		{consts.KeyActionRange3, scopeHistory, &km.Range[2]},
+		{consts.KeyActionRange4, scopeHistory, &km.Range[3]},
+		{consts.KeyActionZoomIn, scopeHistory, &km.ZoomIn},
+		{consts.KeyActionZoomOut, scopeHistory, &km.ZoomOut},
+		{consts.KeyActionPanLeft, scopeHistory, &km.PanLeft},
+		{consts.KeyActionPanRight, scopeHistory, &km.PanRight},
+		{consts.KeyActionLive, scopeHistory, &km.Live},
+		{consts.KeyActionEvents, scopeGlobal, &km.Events},
+		{consts.KeyActionScrollUp, scopeEvents, &km.ScrollUp},
+		{consts.KeyActionScrollDown, scopeEvents, &km.ScrollDown},
+		{consts.KeyActionPageUp, scopeEvents, &km.PageUp},
+		{consts.KeyActionPageDown, scopeEvents, &km.PageDown},
+		{consts.KeyActionTop, scopeEvents, &km.Top},
+		{consts.KeyActionBottom, scopeEvents, &km.Bottom},
+	}
+}
+
+// newBinding builds a binding labelled with its keys.
+func newBinding(desc string, keys ...string) key.Binding {
+	return key.NewBinding(key.WithKeys(keys...), key.WithHelp(keyLabel(keys), desc))
+}
+
+// keyLabel joins keys for help, skipping uppercase twins of earlier keys and
+// drawing arrows as glyphs.
+func keyLabel(keys []string) string {
+	var parts []string
+	for i, k := range keys {
+		twin := false
+		for _, prev := range keys[:i] {
+			if strings.EqualFold(prev, k) {
+				twin = true
+				break
+			}
+		}
+		if twin {
+			continue
+		}
+		if sym, ok := keySymbols[k]; ok {
+			k = sym
+		}
+		parts = append(parts, k)
+	}
+	return strings.Join(parts, consts.HelpKeyJoiner)
+}
+
+// relabel returns a copy of b described by desc.
+func relabel(b key.Binding, desc string) key.Binding {
+	b.SetHelp(b.Help().Key, desc)
+	return b
+}
+
+// joinBindings merges bindings into one help entry showing the first key
+// of each; it is disabled when none of them is bound.
+func joinBindings(desc string, bs ...key.Binding) key.Binding {
+	var keys, labels []string
+	for _, b := range bs {
+		if !b.Enabled() {
+			continue
+		}
+		keys = append(keys, b.Keys()...)
+		labels = append(labels, keyLabel(b.Keys()[:1]))
+	}
+	if len(keys) == 0 {
+		return key.NewBinding(key.WithDisabled())
+	}
+	return key.NewBinding(key.WithKeys(keys...), key.WithHelp(strings.Join(labels, consts.HelpKeyJoiner), desc))
+}
+
+// viewKeys adapts a KeyMap to the help bubble for one view.
+type viewKeys struct {
+	km   KeyMap
+	view viewMode
+}
+
+// ShortHelp returns the footer legend.
+func (v viewKeys) ShortHelp() []key.Binding {
+	k := v.km
+	switch v.view {
+	case viewHistory:
+		return []key.Binding{
+			joinBindings(consts.HelpRangeDesc, k.Range[:]...),
+			joinBindings(consts.HelpPanDesc, k.PanLeft, k.PanRight),
+			joinBindings(consts.HelpZoomDesc, k.ZoomIn, k.ZoomOut),
+			relabel(k.History, consts.HelpBackDesc),
+			relabel(k.Help, consts.HelpOverlayDesc),
+			relabel(k.Quit, consts.HelpQuitDesc),
+		}
+	case viewEvents:
+		return []key.Binding{
+			joinBindings(consts.HelpScrollDesc, k.ScrollUp, k.ScrollDown),
+			joinBindings(consts.HelpPageDesc, k.PageUp, k.PageDown),
+			relabel(k.Events, consts.HelpBackDesc),
+			relabel(k.Help, consts.HelpOverlayDesc),
+			relabel(k.Quit, consts.HelpQuitDesc),
+		}
+	}
+	return []key.Binding{
+		relabel(k.Refresh, consts.HelpRefreshDesc),
+		relabel(k.History, consts.HelpHistoryDesc),
+		relabel(k.Events, consts.HelpEventsDesc),
+		relabel(k.Pause, consts.HelpPauseDesc),
+		relabel(k.Help, consts.HelpOverlayDesc),
+		relabel(k.Quit, consts.HelpQuitDesc),
+		// Narrow footers truncate from the end, so the extras go last.
+		joinBindings(consts.HelpIntervalDesc, k.Faster, k.Slower),
+		relabel(k.Theme, consts.HelpThemeDesc),
+	}
+}
+
+// FullHelp returns the overlay columns.
+func (v viewKeys) FullHelp() [][]key.Binding {
+	k := v.km
+	switch v.view {
+	case viewHistory:
+		return [][]key.Binding{
+			append(append([]key.Binding(nil), k.Range[:]...), k.Live, k.ZoomIn, k.ZoomOut, k.PanLeft, k.PanRight),
+			{k.Refresh, k.Pause, k.Theme, k.History, k.Events, k.Back, k.Help, k.Quit},
+		}
+	case viewEvents:
+		return [][]key.Binding{
+			{k.ScrollUp, k.ScrollDown, k.PageUp, k.PageDown, k.Top, k.Bottom},
+			{k.Refresh, k.Pause, k.Theme, k.History, k.Events, k.Back, k.Help, k.Quit},
+		}
+	}
+	return [][]key.Binding{
+		{k.Refresh, k.Pause, k.Faster, k.Slower, k.Theme},
+		{k.History, k.Events, k.Back, k.Help, k.Quit},
+	}
+}
+
+// newHelp returns a help bubble styled with the current theme.
+//
+// Parameters:
+//   - width: maximum short help width; zero never truncates.
+func newHelp(width int) help.Model {
+	h := help.New()
+	h.Width = width
+	h.ShortSeparator = consts.TextSeparatorDot
+	h.FullSeparator = consts.HelpColumnGap
+	h.Ellipsis = consts.HelpEllipsis
+	h.Styles = help.Styles{
+		Ellipsis:       helpStyle,
+		ShortKey:       helpKeyStyle,
+		ShortDesc:      helpDescStyle,
+		ShortSeparator: helpStyle,
+		FullKey:        helpKeyStyle,
+		FullDesc:       helpDescStyle,
+		FullSeparator:  helpStyle,
+	}
+	return h
+}
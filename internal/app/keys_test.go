@@ -0,0 +1,71 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeKeys writes a keybinding file and returns its path.
+func writeKeys(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadKeyMapRemaps(t *testing.T) {
+	km, err := LoadKeyMap(writeKeys(t, `{"history": ["g"], "theme": []}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys := km.History.Keys(); len(keys) != 1 || keys[0] != "g" {
+		t.Fatalf("history keys %v, want [g]", keys)
+	}
+	if len(km.Theme.Keys()) != 0 {
+		t.Fatalf("theme still bound to %v", km.Theme.Keys())
+	}
+}
+
+func TestLoadKeyMapMissingFile(t *testing.T) {
+	km, err := LoadKeyMap(filepath.Join(t.TempDir(), "absent.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(km.Quit.Keys()) == 0 {
+		t.Fatal("defaults lost for a missing file")
+	}
+}
+
+func TestLoadKeyMapRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "global conflict", content: `{"history": ["q"]}`, wantErr: `"q"`},
+		{name: "unknown action", content: `{"jump": ["j"]}`, wantErr: `"jump"`},
+		{name: "no quit", content: `{"quit": []}`, wantErr: "quit"},
+		{name: "blank key", content: `{"refresh": [" "]}`, wantErr: `"refresh"`},
+		{name: "bad json", content: `{`, wantErr: "keys"},
+	}
+	for _, tt := range tests {
+		_, err := LoadKeyMap(writeKeys(t, tt.content))
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: got %v, want an error mentioning %s", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestLoadKeyMapScopes(t *testing.T) {
+	// Faster lives on the dashboard and Live on the history chart, so they
+	// may share a key.
+	if _, err := LoadKeyMap(writeKeys(t, `{"faster": ["end"]}`)); err != nil {
+		t.Fatalf("keys in disjoint views rejected: %v", err)
+	}
+	if _, err := LoadKeyMap(writeKeys(t, `{"live": ["pgup"]}`)); err != nil {
+		t.Fatalf("history key shared with the event log rejected: %v", err)
+	}
+}
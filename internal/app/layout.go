@@ -0,0 +1,222 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
+	"claude-monitor/internal/utils"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// LayoutMode selects how much chrome the dashboard draws.
+type LayoutMode int
+
+const (
+	// LayoutAuto uses the richest layout that fits the window height.
+	LayoutAuto LayoutMode = iota
+	// LayoutFull draws the logo, bordered chart, and help footer.
+	LayoutFull
+	// LayoutCompact draws one line per window and a status line.
+	LayoutCompact
+	// LayoutMinimal draws a single line.
+	LayoutMinimal
+)
+
+// ParseLayout parses a "-layout" value; empty means auto.
+//
+// Parameters:
+//   - name: auto, full, compact or minimal.
+//
+// Returns:
+//   - the layout.
+//   - error for unknown names.
+func ParseLayout(name string) (LayoutMode, error) {
+	switch strings.TrimSpace(name) {
+	case "", consts.LayoutAutoName:
+		return LayoutAuto, nil
+	case consts.LayoutFullName:
+		return LayoutFull, nil
+	case consts.LayoutCompactName:
+		return LayoutCompact, nil
+	case consts.LayoutMinimalName:
+		return LayoutMinimal, nil
+	}
+	return LayoutAuto, fmt.Errorf(consts.ErrLayoutFmt, name)
+}
+
+// renderCompact draws one bar line per window and a status line, without
+// the logo, border, or help.
+//
+// Parameters:
+//
+//	m - current model.
+//
+// Returns:
+//
+//	string - rendered lines.
+func renderCompact(m model) string {
+	width := utils.Max(m.width, minContainerWidth)
+	rows := m.rows()
+	var lines []string
+	switch {
+	case len(rows) == 0 && m.err != nil:
+		lines = append(lines, errorBannerStyle.MaxWidth(width).
+			Render(fmt.Sprintf(consts.TextErrorBannerFmt, formatError(m.err))))
+	case len(rows) == 0:
+		// The status line says whether a fetch is running.
+	case m.isStale():
+		lines = append(lines, renderStaleBanner(m.err, m.now().Sub(m.lastUpdated), width))
+		lines = append(lines, m.compactBlocks(rows, width)...)
+	default:
+		lines = append(lines, m.compactBlocks(rows, width)...)
+	}
+	status := lipgloss.JoinHorizontal(lipgloss.Top, statusParts(m.footerInfo())...)
+	lines = append(lines, lipgloss.NewStyle().MaxWidth(width).Render(status))
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// compactBlocks renders the compact rows, dimmed when the data is stale and
+// with the detail panel under the expanded row.
+func (m model) compactBlocks(rows []chartRow, width int) []string {
+	opt := barRenderOptions{
+		labelStyle:    labelBaseStyle,
+		valueStyle:    valueBaseStyle,
+		remainStyle:   &remainBaseStyle,
+		barFillStyle:  barFillStyle,
+		barEmptyStyle: barEmptyStyle,
+		bands:         m.cfg.Bands,
+	}
+	if m.isStale() {
+		opt = barRenderOptions{
+			labelStyle:    skeletonLabelStyle,
+			valueStyle:    valueBaseStyle.Foreground(paletteMuted),
+			remainStyle:   ptrStyle(remainBaseStyle.Foreground(paletteMuted)),
+			barFillStyle:  skeletonBarFillStyle,
+			barEmptyStyle: barEmptyStyle,
+		}
+	}
+	opt.detail = m.rowDetail
+	return compactRowBlocks(rows, width, opt)
+}
+
+// renderCompactRows draws each row as "label bar value remaining" on one
+// line. The remaining time is dropped when it would squeeze the bar or the
+// labels.
+//
+// Parameters:
+//
+//	rows  - chart data to render.
+//	width - line width.
+//	opt   - styles; resetStyle and metaBuilder are ignored.
+//
+// Returns:
+//
+//	string - one line per row.
+func renderCompactRows(rows []chartRow, width int, opt barRenderOptions) string {
+	return strings.Join(compactRowBlocks(rows, width, opt), "\n")
+}
+
+// compactMeta is the text after a compact row: the time left, or the reset
+// time when the reset format hides the time left.
+func compactMeta(r chartRow) string {
+	if r.remain != "" {
+		return r.remain
+	}
+	return r.reset
+}
+
+// compactRowBlocks renders each compact row, followed by its detail panel
+// when opt.detail returns one.
+func compactRowBlocks(rows []chartRow, width int, opt barRenderOptions) []string {
+	const (
+		minBarWidth = 8
+		gap         = "  "
+	)
+	remainStyle := pickStyle(opt.remainStyle, remainBaseStyle)
+	metaWidth := 0
+	for _, r := range rows {
+		metaWidth = utils.Max(metaWidth, lipgloss.Width(compactMeta(r)))
+	}
+	metrics := computeBarMetrics(width-metaWidth-len(gap), rows, valueWidthCached())
+	if metaWidth == 0 || metrics.barWidth < minBarWidth || metrics.labelWidth < longestLabel(rows, 0) {
+		metaWidth = 0
+		metrics = computeBarMetrics(width, rows, valueWidthCached())
+	}
+
+	labelStyle := opt.labelStyle.Width(metrics.labelWidth)
+	valueStyle := opt.valueStyle.Width(metrics.valueWidth).AlignHorizontal(lipgloss.Right)
+	lines := make([]string, 0, len(rows))
+	for _, r := range rows {
+		percent := utils.Clamp(r.percent, 0, 100)
+		fillStyle, rowValueStyle := opt.barFillStyle, valueStyle
+		var cellColor func(float64) lipgloss.TerminalColor
+		if opt.bands.enabled() {
+			color := severityColor(opt.bands.level(percent))
+			fillStyle = fillStyle.Background(color)
+			rowValueStyle = rowValueStyle.Foreground(color)
+			if opt.bands.Gradient {
+				cellColor = opt.bands.gradientColor
+			}
+		}
+		line := labelStyle.Render(truncateWidth(r.label, metrics.labelWidth)) + " " +
+			renderProgressBarStyled(metrics.barWidth, percent/100, fillStyle, opt.barEmptyStyle, cellColor) + gap +
+			rowValueStyle.Render(fmt.Sprintf(consts.PercentFmt, percent))
+		if meta := compactMeta(r); metaWidth > 0 && meta != "" {
+			line += gap + remainStyle.Render(meta)
+		}
+		if opt.detail != nil {
+			if detail := opt.detail(r); detail != "" {
+				indent := metrics.labelWidth + 1
+				line += "\n" + lipgloss.NewStyle().MarginLeft(indent).MaxWidth(width).Render(detail)
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// renderMinimal fits the dashboard on one line: each window's percentage,
+// any error, then the status. The tail is cut when the window is narrower.
+//
+// Parameters:
+//
+//	m - current model.
+//
+// Returns:
+//
+//	string - the rendered line.
+func renderMinimal(m model) string {
+	width := utils.Max(m.width, minContainerWidth)
+	sep := separatorStyle.Render(consts.TextSeparatorDot)
+	var parts []string
+	if m.usage != nil {
+		segments, _ := barSegments(store.Sample{Time: m.lastUpdated, UsageResponse: *m.usage}, m.now(), barOptions{
+			threshold: m.cfg.Threshold,
+			bands:     m.cfg.Bands,
+		})
+		for _, s := range segments {
+			color := s.color
+			if m.isStale() {
+				color = paletteMuted
+			}
+			if len(parts) > 0 {
+				parts = append(parts, sep)
+			}
+			parts = append(parts, valueBaseStyle.Foreground(color).Render(s.text))
+		}
+	}
+	if m.err != nil {
+		if len(parts) > 0 {
+			parts = append(parts, sep)
+		}
+		parts = append(parts, errorBannerStyle.Render(fmt.Sprintf(consts.TextErrorBannerFmt, formatError(m.err))))
+	}
+	if len(parts) > 0 {
+		parts = append(parts, sep)
+	}
+	parts = append(parts, statusParts(m.footerInfo())...)
+	return lipgloss.NewStyle().MaxWidth(width).Render(lipgloss.JoinHorizontal(lipgloss.Top, parts...))
+}
@@ -3,14 +3,19 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/rand"
 	"time"
 
 	"claude-monitor/internal/api"
+	"claude-monitor/internal/buildinfo"
 	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
 	"claude-monitor/internal/utils"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -24,12 +29,30 @@ type chartRow struct {
 	percent float64
 	reset   string
 	remain  string
+	// utilization is the API value before clamping and rounding.
+	utilization float64
+	// resetsAt is when the window rolls over; zero when the API omits it.
+	resetsAt time.Time
+	// span is the window length.
+	span time.Duration
 }
 
-// usageMsg wraps usage data or an error returned from the API request.
+// Window lengths of the rate limits the API reports.
+const (
+	fiveHourSpan = 5 * time.Hour
+	sevenDaySpan = 7 * 24 * time.Hour
+)
+
+// usageMsg wraps usage data or an error returned from the API request. id
+// matches the model's fetchID for the request in flight; results of requests
+// abandoned by pausing are ignored.
 type usageMsg struct {
+	id   int
 	data api.UsageResponse
+	beta string
 	err  error
+	// latency is how long the request took.
+	latency time.Duration
 }
 
 // model holds all Bubble Tea state for the application.
@@ -37,17 +60,56 @@ type model struct {
 	cfg         Config
 	baseCtx     context.Context
 	width       int
-	rows        []chartRow
+	height      int
+	view        viewMode
+	history     historyView
+	usage       *api.UsageResponse
+	beta        string
+	fromCache   bool
 	lastUpdated time.Time
 	loading     bool
 	err         error
 	sp          spinner.Model
 	cancel      context.CancelFunc
 	failures    int
+	sched       scheduler
+	tickID      int
+	fetchID     int
+	nextPoll    time.Time
+	// clock replaces time.Now when rendering; nil uses the wall clock.
+	clock func() time.Time
+	// version is shown in the footer when it fits; empty hides it.
+	version string
+	// themeIdx indexes cfg.Themes for the theme cycle key.
+	themeIdx int
+	// notice is a short status note, such as the theme just selected; the
+	// next fetch clears it.
+	notice string
+	// paused stops scheduled polling until resumed; manual refreshes still
+	// run once.
+	paused bool
+	// keys maps key presses to actions and feeds the help views.
+	keys KeyMap
+	// showHelp replaces the screen with the full help overlay.
+	showHelp bool
+	// events is the log of fetches, errors, resets, and threshold
+	// crossings, oldest first.
+	events []event
+	// eventsVP scrolls the event log.
+	eventsVP viewport.Model
+	// expanded is the label of the row whose detail panel is open; empty
+	// when none is.
+	expanded string
+	// changes holds the last utilization change of each row label.
+	changes map[string]rowChange
 }
 
-// tickMsg signals that the refresh interval elapsed.
-type tickMsg struct{}
+// tickMsg signals that a scheduled refresh is due. id matches the model's
+// tickID for the most recently scheduled tick; older ticks are ignored so a
+// manual refresh never leaves two polling chains running.
+type tickMsg struct {
+	id int
+}
 
 // initialModel builds the starting model state for the UI.
 //
@@ -55,16 +117,41 @@ type tickMsg struct{}
 //   - cfg: validated Config used to seed model state and refresh cadence.
 //
 // Returns:
-//   - a model with spinner initialized, loading set, and default width.
+//   - a model with spinner initialized, default width, and the cached
+//     snapshot (if any) shown as stale data.
 func initialModel(ctx context.Context, cfg Config) model {
-	return model{
+	m := model{
 		cfg:         cfg,
 		baseCtx:     ctx,
 		width:       80,
 		loading:     false,
 		lastUpdated: time.Time{},
 		sp:          newSpinner(),
+		sched:       newScheduler(cfg),
+		version:     buildinfo.Read().Short(),
+		keys:        DefaultKeyMap(),
+	}
+	if cfg.Keys != nil {
+		m.keys = *cfg.Keys
+	}
+	m.eventsVP = newEventsViewport(m.keys)
+	if cfg.Snapshot != nil {
+		if snap, err := cfg.Snapshot.Load(); err == nil {
+			m.usage = &snap.UsageResponse
+			m.lastUpdated = snap.Time
+			m.fromCache = true
+		}
+	}
+	return m
+}
+
+// now returns the model's render time: the fixed clock when one is set,
+// otherwise the wall clock.
+func (m model) now() time.Time {
+	if m.clock != nil {
+		return m.clock()
 	}
+	return time.Now()
 }
 
 // newSpinner constructs a spinner with the application's base styling.
@@ -81,7 +168,7 @@ func newSpinner() spinner.Model {
 //
 //	tea.Cmd - batch command to kick off data fetch and spinner.
 func (m model) Init() tea.Cmd {
-	return tea.Batch(tickCmd(0), m.sp.Tick)
+	return tea.Batch(tickCmd(0, m.tickID), m.sp.Tick)
 }
 
 // Update routes incoming messages to state handlers and returns the next command.
@@ -96,6 +183,8 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
+		m.height = msg.Height
+		m.syncEvents()
 		return m, nil
 	case spinner.TickMsg:
 		var cmd tea.Cmd
@@ -105,11 +194,18 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 	case tickMsg:
+		if msg.id != m.tickID {
+			return m, nil
+		}
 		return m.handleTick()
 	case usageMsg:
 		return m.handleUsage(msg)
+	case historyMsg:
+		return m.handleHistory(msg)
 	case tea.KeyMsg:
 		return m.handleKey(msg)
+	case tea.MouseMsg:
+		return m.handleMouse(msg)
 	}
 	return m, nil
 }
@@ -119,26 +215,37 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 // Params:
 //   - cfg: provides HTTP client, timeout, and token used for the request.
 //   - ctx: deadline/timeout context for the call.
+//   - id: request identifier echoed back in usageMsg.
 //
 // Returns:
 //   - a command that fetches usage and emits usageMsg containing data or error.
-func fetchUsageCmd(cfg Config, ctx context.Context, cancel context.CancelFunc) tea.Cmd {
+func fetchUsageCmd(cfg Config, ctx context.Context, cancel context.CancelFunc, id int) tea.Cmd {
 	return func() tea.Msg {
 		defer cancel()
-		data, err := api.FetchUsage(ctx, cfg.HTTPClient, cfg.Token, cfg.BetaHeader)
-		return usageMsg{data: data, err: err}
+		start := time.Now()
+		data, beta, err := fetchAndPersist(ctx, cfg)
+		return usageMsg{id: id, data: data, beta: beta, err: err, latency: time.Since(start)}
 	}
 }
 
-// tickCmd schedules the next periodic refresh.
+// tickCmd schedules the next refresh.
 //
 // Params:
 //   - interval: duration before the next tick fires.
+//   - id: tick identifier echoed back in tickMsg.
 //
 // Returns:
 //   - a command that will send tickMsg after interval elapses.
-func tickCmd(interval time.Duration) tea.Cmd {
-	return tea.Tick(interval, func(time.Time) tea.Msg { return tickMsg{} })
+func tickCmd(interval time.Duration, id int) tea.Cmd {
+	return tea.Tick(interval, func(time.Time) tea.Msg { return tickMsg{id: id} })
+}
+
+// scheduleTick replaces any pending tick with one firing after d and records
+// when it is due for the footer.
+func (m model) scheduleTick(d time.Duration) (model, tea.Cmd) {
+	m.tickID++
+	m.nextPoll = time.Now().Add(d)
+	return m, tickCmd(d, m.tickID)
 }
 
 // effectiveTimeout picks the smaller of the HTTP client timeout and refresh
@@ -156,40 +263,25 @@ func effectiveTimeout(cfg Config) time.Duration {
 // newRequestContext builds a request-scoped context bounded by the smaller of
 // the HTTP client timeout and the refresh interval.
 func (m model) newRequestContext() (context.Context, context.CancelFunc) {
-	timeout := effectiveTimeout(m.cfg)
+	return requestContext(m.baseCtx, m.cfg)
+}
+
+// requestContext derives a per-request context from parent using
+// effectiveTimeout, falling back to a short default when no timeout is set.
+func requestContext(parent context.Context, cfg Config) (context.Context, context.CancelFunc) {
+	timeout := effectiveTimeout(cfg)
 	if timeout <= 0 {
-		timeout = m.cfg.HTTPClient.Timeout
+		timeout = cfg.HTTPClient.Timeout
 	}
 	if timeout <= 0 {
 		timeout = 2 * time.Second
 	}
-	parent := m.baseCtx
 	if parent == nil {
 		parent = context.Background()
 	}
 	return context.WithTimeout(parent, timeout)
 }
 
-func retryInterval(base time.Duration, failures int, retryAfter time.Duration) time.Duration {
-	if retryAfter > 0 {
-		return retryAfter
-	}
-	if failures <= 0 || base <= 0 {
-		return base
-	}
-	step := failures
-	if step > 3 {
-		step = 3
-	}
-	backoff := base * time.Duration(1<<step)
-	maxBackoff := base * 8
-	if backoff > maxBackoff {
-		backoff = maxBackoff
-	}
-	jitter := time.Duration(rand.Int63n(int64(backoff / 5))) // up to +20%
-	return backoff + jitter
-}
-
 // handleTick triggers a refresh cycle.
 //
 // Returns:
@@ -203,35 +295,58 @@ func (m model) handleTick() (tea.Model, tea.Cmd) {
 	return m.startFetch()
 }
 
-// handleUsage ingests fetched usage data or records an error.
+// handleUsage ingests fetched usage data or records an error. On error the
+// last good data is kept so the view can keep showing it as stale.
 //
 // Params:
 //   - msg: usageMsg carrying API data or an error.
 //
 // Returns:
-//   - the updated model with rows/lastUpdated or error set.
-//   - a command scheduling the next tick based on refresh interval.
+//   - the updated model with usage/lastUpdated or error set.
+//   - a command scheduling the next tick chosen by the scheduler.
 func (m model) handleUsage(msg usageMsg) (tea.Model, tea.Cmd) {
+	if msg.id != m.fetchID {
+		// A fetch abandoned by pausing; a newer one may be in flight.
+		return m, nil
+	}
 	if msg.err != nil {
 		m.err = msg.err
 		m.loading = false
 		m.failures++
-		var retryAfter time.Duration
-		var httpErr api.HTTPError
-		if errors.As(msg.err, &httpErr) {
-			retryAfter = httpErr.RetryAfter
+		delay := m.sched.afterError(m.failures, retryAfterOf(msg.err))
+		if m.paused {
+			delay = 0
 		}
-		return m, tickCmd(retryInterval(m.cfg.RefreshEvery, m.failures, retryAfter))
+		m.logEvents(errorEvents(time.Now(), msg.latency, msg.err, m.failures, delay)...)
+		return m.scheduleNext(delay)
+	}
+	m.logEvents(fetchEvents(time.Now(), msg.latency, m.usage, msg.data, m.cfg.Threshold)...)
+	m.trackChanges(msg.data, time.Now())
+	prev := m.usage
+	if m.fromCache {
+		prev = nil
 	}
+	delay := m.sched.afterSuccess(time.Now(), prev, msg.data)
 	m.failures = 0
 	m.err = nil
-	m.rows = buildRows(msg.data)
+	m.usage = &msg.data
+	m.beta = msg.beta
+	m.fromCache = false
 	m.lastUpdated = time.Now()
+	m.recordHistory(store.Sample{Time: m.lastUpdated, UsageResponse: msg.data})
 	m.loading = false
-	if len(m.rows) == 0 {
+	if len(buildRows(msg.data, m.lastUpdated)) == 0 {
 		m.err = errors.New(consts.TextNoData)
 	}
-	return m, tickCmd(m.cfg.RefreshEvery)
+	return m.scheduleNext(delay)
+}
+
+// scheduleNext schedules the next poll after d unless polling is paused.
+func (m model) scheduleNext(d time.Duration) (tea.Model, tea.Cmd) {
+	if m.paused {
+		return m, nil
+	}
+	return m.scheduleTick(d)
 }
 
 // handleKey processes user input shortcuts.
@@ -243,77 +358,196 @@ func (m model) handleUsage(msg usageMsg) (tea.Model, tea.Cmd) {
 //   - the model (possibly reset to loading).
 //   - a command to quit, refetch, or no-op based on the key.
 func (m model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case consts.HelpQuitKey, consts.HelpQuitCtrlKey:
+	k := m.keys
+	if key.Matches(msg, k.Quit) {
 		if m.cancel != nil {
 			m.cancel()
 		}
 		return m, tea.Quit
-	case consts.HelpRefreshKey, consts.HelpRefreshKeyUpper:
+	}
+	if m.showHelp {
+		// The overlay swallows everything but quit and its own close keys.
+		if key.Matches(msg, k.Help, k.Back) {
+			m.showHelp = false
+		}
+		return m, nil
+	}
+	switch m.view {
+	case viewHistory:
+		if next, ok := m.handleHistoryKey(msg); ok {
+			return next, nil
+		}
+	case viewEvents:
+		if next, ok := m.handleEventsKey(msg); ok {
+			return next, nil
+		}
+	}
+	switch {
+	case key.Matches(msg, k.Help):
+		m.showHelp = true
+		return m, nil
+	case key.Matches(msg, k.Refresh):
 		if m.loading {
 			return m, nil
 		}
 		return m.startFetch()
+	case key.Matches(msg, k.History):
+		return m.toggleHistory()
+	case key.Matches(msg, k.Events):
+		return m.toggleEvents()
+	case key.Matches(msg, k.Back):
+		if m.view == viewDashboard {
+			m.expanded = ""
+			return m, nil
+		}
+		m.view = viewDashboard
+		return m, nil
+	case key.Matches(msg, k.Theme):
+		return m.cycleTheme(), nil
+	case key.Matches(msg, k.Pause):
+		return m.togglePause()
+	case key.Matches(msg, k.Faster):
+		return m.stepInterval(-1)
+	case key.Matches(msg, k.Slower):
+		return m.stepInterval(1)
 	default:
 		return m, nil
 	}
 }
 
+// togglePause stops polling, canceling any fetch in flight, or resumes it
+// with an immediate fetch.
+func (m model) togglePause() (tea.Model, tea.Cmd) {
+	if m.paused {
+		m.paused = false
+		if m.loading {
+			// A manual refresh made while paused schedules the next poll
+			// when it lands.
+			return m, nil
+		}
+		return m.startFetch()
+	}
+	m.paused = true
+	if m.cancel != nil {
+		m.cancel()
+	}
+	// Bumping the ids drops the pending tick and the canceled fetch's
+	// result, so resuming always starts a fresh request.
+	m.tickID++
+	m.fetchID++
+	m.loading = false
+	m.nextPoll = time.Time{}
+	return m, nil
+}
+
+// stepInterval moves the base interval to the next preset in dir and
+// reschedules the pending poll on the new cadence. A retry after a failure
+// keeps its delay, so Retry-After and the backoff still hold; otherwise the
+// delay is recomputed from the last fetch as the scheduler would have chosen
+// it, bounds and reset alignment included.
+func (m model) stepInterval(dir int) (tea.Model, tea.Cmd) {
+	base := stepInterval(m.sched.base, dir)
+	if base == m.sched.base {
+		return m, nil
+	}
+	m.sched = m.sched.withBase(m.cfg, base)
+	if m.loading || m.paused || m.failures > 0 {
+		return m, nil
+	}
+	if m.usage == nil || m.lastUpdated.IsZero() {
+		return m.scheduleTick(m.sched.clamp(base))
+	}
+	d := time.Until(m.lastUpdated.Add(m.sched.afterSuccess(m.lastUpdated, nil, *m.usage)))
+	if d < 0 {
+		d = 0
+	}
+	return m.scheduleTick(d)
+}
+
+// cycleTheme applies the next theme in cfg.Themes and notes its name in the
+// status line. It is a no-op with fewer than two themes.
+func (m model) cycleTheme() model {
+	if len(m.cfg.Themes) < 2 {
+		return m
+	}
+	m.themeIdx = (m.themeIdx + 1) % len(m.cfg.Themes)
+	t := m.cfg.Themes[m.themeIdx]
+	ApplyTheme(t)
+	m.sp.Style = spinnerStyle
+	// The log lines carry the old theme's colors.
+	m.syncEvents()
+	m.notice = fmt.Sprintf(consts.TextThemeFmt, t.Name)
+	return m
+}
+
 // startFetch marks the model as loading and fires a new usage request while
 // continuing the spinner animation.
 func (m model) startFetch() (tea.Model, tea.Cmd) {
+	// The previous error stays visible until a fetch succeeds, so a retry
+	// never blanks out the stale banner.
 	m.loading = true
-	m.err = nil
+	m.notice = ""
+	m.nextPoll = time.Time{}
 	if m.cancel != nil {
 		m.cancel()
 	}
 	ctx, cancel := m.newRequestContext()
 	m.cancel = cancel
-	return m, tea.Batch(fetchUsageCmd(m.cfg, ctx, cancel), m.sp.Tick)
+	m.fetchID++
+	return m, tea.Batch(fetchUsageCmd(m.cfg, ctx, cancel, m.fetchID), m.sp.Tick)
 }
 
 // buildRows constructs chart rows for each usage window.
 //
 // Params:
 //   - u: usage response from the API.
+//   - now: reference time for the remaining-time text.
 //
 // Returns:
 //   - slice of chartRow for windows that contain utilization data.
-func buildRows(u api.UsageResponse) []chartRow {
+func buildRows(u api.UsageResponse, now time.Time) []chartRow {
 	return buildChartRows([]struct {
 		label string
 		win   *api.WindowUsage
+		span  time.Duration
 	}{
-		{label: consts.LabelCurrent, win: u.FiveHour},
-		{label: consts.LabelWeekly, win: u.SevenDay},
-	})
+		{label: consts.LabelCurrent, win: u.FiveHour, span: fiveHourSpan},
+		{label: consts.LabelWeekly, win: u.SevenDay, span: sevenDaySpan},
+	}, now)
 }
 
 // buildChartRows normalizes window usage items into chartRow slices.
 //
 // Params:
-//   - items: labeled window usage pointers.
+//   - items: labeled window usage pointers with their window lengths.
+//   - now: reference time for the remaining-time text.
 //
 // Returns:
 //   - chart rows containing clamped utilization and formatted reset/remain text.
 func buildChartRows(items []struct {
 	label string
 	win   *api.WindowUsage
-}) []chartRow {
+	span  time.Duration
+}, now time.Time) []chartRow {
 	rows := make([]chartRow, 0, len(items))
 	for _, item := range items {
 		if item.win == nil || item.win.Utilization == nil {
 			continue
 		}
 		reset, remain := "", ""
+		var resetsAt time.Time
 		if item.win.ResetsAt != nil {
-			reset, remain = utils.FormatReset(*item.win.ResetsAt)
+			resetsAt = *item.win.ResetsAt
+			reset, remain = utils.FormatResetAt(resetsAt, now)
 		}
 		rows = append(rows, chartRow{
-			label:   item.label,
-			percent: utils.Clamp(*item.win.Utilization, 0, 100),
-			reset:   reset,
-			remain:  remain,
+			label:       item.label,
+			percent:     utils.Clamp(*item.win.Utilization, 0, 100),
+			reset:       reset,
+			remain:      remain,
+			utilization: *item.win.Utilization,
+			resetsAt:    resetsAt,
+			span:        item.span,
 		})
 	}
 	return rows
@@ -0,0 +1,84 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"claude-monitor/internal/api"
+)
+
+func TestResumeDropsCanceledFetch(t *testing.T) {
+	m := initialModel(context.Background(), Config{
+		RefreshEvery: 30 * time.Second,
+		HTTPClient:   &http.Client{Timeout: time.Second},
+	})
+	next, _ := m.startFetch()
+	stale := next.(model).fetchID
+	next, _ = next.(model).togglePause()
+	if next.(model).loading {
+		t.Fatal("still loading after pause")
+	}
+	next, _ = next.(model).togglePause()
+	m = next.(model)
+	if !m.loading || m.fetchID == stale {
+		t.Fatal("resume did not start a fresh fetch")
+	}
+
+	// The canceled request lands after the resume.
+	next, _ = m.handleUsage(usageMsg{id: stale, err: context.Canceled})
+	m = next.(model)
+	if m.err != nil || m.failures != 0 || len(m.events) != 0 || !m.loading {
+		t.Fatalf("stale result handled: err %v, failures %d, %d events, loading %v", m.err, m.failures, len(m.events), m.loading)
+	}
+
+	pct := 10.0
+	next, _ = m.handleUsage(usageMsg{id: m.fetchID, data: api.UsageResponse{FiveHour: &api.WindowUsage{Utilization: &pct}}})
+	m = next.(model)
+	if m.loading || m.usage == nil || m.nextPoll.IsZero() {
+		t.Fatalf("fresh result not applied: loading %v, usage %v, next poll %v", m.loading, m.usage, m.nextPoll)
+	}
+}
+
+func TestStepIntervalWhileBackingOff(t *testing.T) {
+	m := initialModel(context.Background(), Config{
+		RefreshEvery: 30 * time.Second,
+		HTTPClient:   &http.Client{Timeout: time.Second},
+	})
+	next, _ := m.startFetch()
+	m = next.(model)
+	next, _ = m.handleUsage(usageMsg{id: m.fetchID, err: api.HTTPError{Status: http.StatusTooManyRequests, RetryAfter: 5 * time.Minute}})
+	m = next.(model)
+	poll, tick := m.nextPoll, m.tickID
+
+	next, cmd := m.stepInterval(-1)
+	m = next.(model)
+	if cmd != nil || m.tickID != tick || !m.nextPoll.Equal(poll) {
+		t.Fatalf("pending retry replaced: next poll %v, want %v", m.nextPoll, poll)
+	}
+	if m.sched.base != 15*time.Second {
+		t.Fatalf("base %v, want 15s", m.sched.base)
+	}
+}
+
+func TestStepIntervalKeepsBounds(t *testing.T) {
+	m := initialModel(context.Background(), Config{
+		RefreshEvery: 2 * time.Minute,
+		MinRefresh:   time.Minute,
+		HTTPClient:   &http.Client{Timeout: time.Second},
+	})
+	pct := 50.0
+	m.usage = &api.UsageResponse{FiveHour: &api.WindowUsage{Utilization: &pct}}
+	m.lastUpdated = time.Now()
+
+	next, _ := m.stepInterval(-1)
+	next, _ = next.(model).stepInterval(-1)
+	m = next.(model)
+	if m.sched.base != 30*time.Second {
+		t.Fatalf("base %v, want 30s", m.sched.base)
+	}
+	if d := time.Until(m.nextPoll); d < 55*time.Second {
+		t.Fatalf("next poll in %v, want the explicit 1m minimum", d)
+	}
+}
@@ -0,0 +1,272 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"claude-monitor/internal/api"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/utils"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// viewOrder is the order the scroll wheel moves through the screens.
+var viewOrder = []viewMode{viewDashboard, viewHistory, viewEvents}
+
+// minPaceElapsed is the share of a window that must have passed before
+// the detail panel projects usage to the reset.
+const minPaceElapsed = 0.05
+
+// rowChange is the last utilization change seen for a row.
+type rowChange struct {
+	at    time.Time
+	delta float64
+}
+
+// hitArea is a clickable band of screen lines from top up to, but not
+// including, bottom.
+type hitArea struct {
+	top, bottom int
+	// label names the row the band belongs to; empty for the footer.
+	label string
+}
+
+// contains reports whether screen line y falls inside the band.
+func (a hitArea) contains(y int) bool {
+	return y >= a.top && y < a.bottom
+}
+
+// handleMouse maps clicks and the wheel to actions: a row click opens or
+// closes its detail panel, a footer click refreshes, and the wheel moves
+// between the dashboard, history chart, and event log.
+//
+// Returns:
+//   - the updated model and any command the action started.
+func (m model) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if msg.Action != tea.MouseActionPress {
+		return m, nil
+	}
+	switch msg.Button {
+	case tea.MouseButtonWheelDown:
+		return m.stepView(1)
+	case tea.MouseButtonWheelUp:
+		return m.stepView(-1)
+	case tea.MouseButtonLeft:
+	default:
+		return m, nil
+	}
+	if m.showHelp {
+		m.showHelp = false
+		return m, nil
+	}
+	mode, out := m.render()
+	if m.footerArea(mode, out).contains(msg.Y) {
+		if m.loading {
+			return m, nil
+		}
+		return m.startFetch()
+	}
+	if m.view != viewDashboard {
+		return m, nil
+	}
+	for _, a := range m.rowAreas(mode) {
+		if a.contains(msg.Y) {
+			m.toggleDetail(a.label)
+			break
+		}
+	}
+	return m, nil
+}
+
+// stepView moves step screens along viewOrder, stopping at either end.
+func (m model) stepView(step int) (tea.Model, tea.Cmd) {
+	if m.showHelp {
+		return m, nil
+	}
+	cur := 0
+	for i, v := range viewOrder {
+		if v == m.view {
+			cur = i
+		}
+	}
+	next := cur + step
+	if next < 0 || next >= len(viewOrder) {
+		return m, nil
+	}
+	switch viewOrder[next] {
+	case viewHistory:
+		return m.toggleHistory()
+	case viewEvents:
+		return m.toggleEvents()
+	}
+	m.view = viewDashboard
+	return m, nil
+}
+
+// toggleDetail opens the detail panel of the labeled row, or closes it when
+// it is already open.
+func (m *model) toggleDetail(label string) {
+	if m.expanded == label {
+		m.expanded = ""
+		return
+	}
+	m.expanded = label
+}
+
+// footerArea locates the status footer in the rendered screen out.
+//
+// Parameters:
+//   - mode: layout that drew out.
+//   - out: the rendered screen.
+//
+// Returns:
+//   - the footer lines; the whole line in the minimal layout.
+func (m model) footerArea(mode LayoutMode, out string) hitArea {
+	height := lipgloss.Height(out)
+	switch mode {
+	case LayoutCompact, LayoutMinimal:
+		return hitArea{top: height - 1, bottom: height}
+	}
+	bottom := height - pageStyle.GetPaddingBottom()
+	footer := renderFullFooter(newLayout(m.width), m)
+	return hitArea{top: bottom - (lipgloss.Height(footer) - footerStyle.GetMarginTop()), bottom: bottom}
+}
+
+// rowAreas locates each dashboard row, including its metadata and detail
+// lines, by measuring the same blocks the view draws.
+//
+// Parameters:
+//   - mode: layout currently drawn.
+//
+// Returns:
+//   - one band per row; none in the minimal layout or without data.
+func (m model) rowAreas(mode LayoutMode) []hitArea {
+	rows := m.rows()
+	if len(rows) == 0 || mode == LayoutMinimal {
+		return nil
+	}
+	var top, gap int
+	var blocks []string
+	if mode == LayoutCompact {
+		width := utils.Max(m.width, minContainerWidth)
+		if m.isStale() {
+			top += lipgloss.Height(renderStaleBanner(m.err, m.now().Sub(m.lastUpdated), width))
+		}
+		blocks = m.compactBlocks(rows, width)
+	} else {
+		chartWidth, innerWidth := chartWidths(newLayout(m.width))
+		top = pageStyle.GetPaddingTop() + lipgloss.Height(headerCached())
+		if m.isStale() {
+			top += lipgloss.Height(renderStaleBanner(m.err, m.now().Sub(m.lastUpdated), chartWidth))
+		}
+		top += chartBoxStyle.GetBorderTopSize() + chartBoxStyle.GetPaddingTop()
+		blocks = m.barBlocks(rows, innerWidth)
+		gap = barBlockStyle.GetMarginBottom()
+	}
+	areas := make([]hitArea, 0, len(blocks))
+	for i, b := range blocks {
+		height := lipgloss.Height(b)
+		bottom := top + height
+		if i < len(blocks)-1 {
+			bottom -= gap
+		}
+		areas = append(areas, hitArea{top: top, bottom: bottom, label: rows[i].label})
+		top += height
+	}
+	return areas
+}
+
+// trackChanges records which rows moved between the shown usage and cur.
+//
+// Parameters:
+//   - cur: freshly fetched usage.
+//   - at: fetch time.
+func (m *model) trackChanges(cur api.UsageResponse, at time.Time) {
+	if m.usage == nil {
+		return
+	}
+	before := make(map[string]float64)
+	for _, r := range buildRows(*m.usage, at) {
+		before[r.label] = r.utilization
+	}
+	for _, r := range buildRows(cur, at) {
+		was, ok := before[r.label]
+		if !ok || was == r.utilization {
+			continue
+		}
+		if m.changes == nil {
+			m.changes = make(map[string]rowChange)
+		}
+		m.changes[r.label] = rowChange{at: at, delta: r.utilization - was}
+	}
+}
+
+// rowDetail renders the detail panel of r when it is the expanded row:
+// exact utilization, reset time in the reset time zone and UTC, window
+// start, pace, and the last change.
+//
+// Parameters:
+//   - r: chart row with its raw values.
+//
+// Returns:
+//   - the panel lines, or empty when r is collapsed.
+func (m model) rowDetail(r chartRow) string {
+	if r.label == "" || r.label != m.expanded {
+		return ""
+	}
+	now := m.now()
+	resets, started := consts.DetailUnknown, consts.DetailUnknown
+	if !r.resetsAt.IsZero() {
+		loc := utils.ResetLocation()
+		resets = utils.FormatClockIn(r.resetsAt, now, loc)
+		if !utils.SameZoneAt(loc, time.UTC, r.resetsAt) {
+			resets = fmt.Sprintf(consts.DetailBothZonesFmt, resets, utils.FormatClockIn(r.resetsAt, now, time.UTC))
+		}
+		started = utils.FormatClockIn(r.resetsAt.Add(-r.span), now, loc)
+	}
+	change := consts.DetailChangeNone
+	if c, ok := m.changes[r.label]; ok {
+		change = fmt.Sprintf(consts.DetailChangeFmt, c.delta, utils.FriendlyDuration(now.Sub(c.at)))
+	}
+	lines := [][2]string{
+		{consts.DetailExactLabel, fmt.Sprintf(consts.DetailExactFmt, r.utilization)},
+		{consts.DetailResetsLabel, resets},
+		{consts.DetailStartedLabel, started},
+		{consts.DetailPaceLabel, paceText(r, now)},
+		{consts.DetailChangeLabel, change},
+	}
+	keyWidth := 0
+	for _, l := range lines {
+		keyWidth = utils.Max(keyWidth, lipgloss.Width(l[0]))
+	}
+	keyStyle := detailKeyStyle.Width(keyWidth + 2)
+	out := make([]string, 0, len(lines))
+	for _, l := range lines {
+		out = append(out, keyStyle.Render(l[0])+detailValueStyle.Render(l[1]))
+	}
+	return strings.Join(out, "\n")
+}
+
+// paceText compares usage with spending the window evenly and projects it
+// to the reset.
+//
+// Parameters:
+//   - r: chart row with its raw values.
+//   - now: reference time.
+//
+// Returns:
+//   - the pace line, or a placeholder without a reset time or early on.
+func paceText(r chartRow, now time.Time) string {
+	if r.resetsAt.IsZero() || r.span <= 0 {
+		return consts.DetailUnknown
+	}
+	elapsed := float64(now.Sub(r.resetsAt.Add(-r.span))) / float64(r.span)
+	if elapsed < minPaceElapsed {
+		return consts.DetailPaceEarly
+	}
+	elapsed = utils.Clamp(elapsed, 0, 1)
+	return fmt.Sprintf(consts.DetailPaceFmt, r.utilization/(elapsed*100), r.utilization/elapsed)
+}
@@ -0,0 +1,108 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"claude-monitor/internal/api"
+	"claude-monitor/internal/store"
+)
+
+// PollResult reports the outcome of one fetch made by Poll.
+type PollResult struct {
+	// Time is when the fetch completed.
+	Time time.Time
+	// Usage holds the response on success.
+	Usage api.UsageResponse
+	// Beta is the anthropic-beta value that worked on success.
+	Beta string
+	// Err is non-nil when the fetch failed.
+	Err error
+	// Failures counts consecutive failed fetches, including this one.
+	Failures int
+	// Next is the delay the scheduler chose before the following fetch.
+	Next time.Duration
+}
+
+// Poll fetches usage on the same adaptive schedule and backoff as the TUI
+// until ctx is canceled, persisting each success to the configured sample log
+// and snapshot and reporting every attempt to onResult.
+//
+// Parameters:
+//   - ctx: stops polling when canceled.
+//   - cfg: validated Config.
+//   - onResult: optional callback invoked after every fetch.
+//
+// Returns:
+//   - nil once ctx is canceled.
+func Poll(ctx context.Context, cfg Config, onResult func(PollResult)) error {
+	sched := newScheduler(cfg)
+	var prev *api.UsageResponse
+	failures := 0
+
+	for {
+		reqCtx, cancel := requestContext(ctx, cfg)
+		data, beta, err := fetchAndPersist(reqCtx, cfg)
+		cancel()
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		res := PollResult{Time: time.Now(), Usage: data, Beta: beta, Err: err}
+		if err != nil {
+			failures++
+			res.Next = sched.afterError(failures, retryAfterOf(err))
+		} else {
+			failures = 0
+			res.Next = sched.afterSuccess(res.Time, prev, data)
+			prev = &data
+		}
+		res.Failures = failures
+		if onResult != nil {
+			onResult(res)
+		}
+
+		timer := time.NewTimer(res.Next)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+	}
+}
+
+// fetchAndPersist fetches usage once (falling back through beta header
+// candidates) and, on success, appends it to the sample log and replaces the
+// snapshot. Persistence is best-effort; a full disk must not break monitoring.
+//
+// Parameters:
+//   - ctx: request context.
+//   - cfg: provides client, token, beta headers, and persistence targets.
+//
+// Returns:
+//   - usage response, the beta header that worked, and the fetch error.
+func fetchAndPersist(ctx context.Context, cfg Config) (api.UsageResponse, string, error) {
+	data, beta, err := fetchUsage(ctx, cfg)
+	if err != nil {
+		return data, beta, err
+	}
+	sample := store.Sample{Time: time.Now(), UsageResponse: data}
+	if cfg.Samples != nil {
+		_ = cfg.Samples.Append(sample)
+	}
+	if cfg.Snapshot != nil {
+		_ = cfg.Snapshot.Save(sample)
+	}
+	return data, beta, nil
+}
+
+// retryAfterOf extracts the server's Retry-After from an HTTP error.
+func retryAfterOf(err error) time.Duration {
+	var httpErr api.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.RetryAfter
+	}
+	return 0
+}
@@ -0,0 +1,179 @@
+package app
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/report"
+	"claude-monitor/internal/utils"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+)
+
+// reportBarWidth is the width of the inline peak bars in report tables.
+const reportBarWidth = 12
+
+// RenderReport formats an aggregated usage report.
+//
+// Parameters:
+//   - rep: aggregated report.
+//   - format: consts.FormatText for styled terminal output or
+//     consts.FormatMarkdown for pasteable Markdown.
+//
+// Returns:
+//   - rendered report ending in a newline.
+//   - error for unknown formats.
+func RenderReport(rep report.Report, format string) (string, error) {
+	switch format {
+	case consts.FormatText:
+		return renderReportText(rep), nil
+	case consts.FormatMarkdown:
+		return renderReportMarkdown(rep), nil
+	}
+	return "", fmt.Errorf(consts.ErrFormatFmt, format)
+}
+
+// renderReportText renders the report as lipgloss tables with inline bars.
+func renderReportText(rep report.Report) string {
+	var b strings.Builder
+	b.WriteString(headerStyle.MarginBottom(0).Padding(0, 1).Render(consts.TextReportTitle))
+	b.WriteString("\n")
+	b.WriteString(statusStyle.Render(reportRange(rep)))
+	b.WriteString("\n\n")
+
+	if len(rep.Rows) == 0 {
+		b.WriteString(consts.TextReportEmpty)
+		b.WriteString("\n")
+		return b.String()
+	}
+
+	t := table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(paletteAccent)).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			style := lipgloss.NewStyle().Padding(0, 1)
+			if row == 0 {
+				return style.Foreground(paletteAccentHi).Bold(true)
+			}
+			if col >= 3 {
+				return style.Align(lipgloss.Right)
+			}
+			return style
+		}).
+		Headers(reportHeaders(rep)...)
+
+	for _, r := range rep.Rows {
+		bar := renderProgressBarStyled(reportBarWidth, utils.Clamp(r.Peak, 0, 100)/100, barFillStyle, barEmptyStyle, nil)
+		peak := bar + " " + valueBaseStyle.Render(fmt.Sprintf(consts.PercentFmt, r.Peak))
+		t.Row(r.Period, r.Window.Label(), peak, reportDuration(r.AboveThreshold),
+			strconv.Itoa(r.LimitHits), strconv.Itoa(r.Resets), strconv.Itoa(r.Samples))
+	}
+	b.WriteString(t.Render())
+	b.WriteString("\n\n")
+
+	b.WriteString(labelBaseStyle.Render(consts.TextReportResetsTitle))
+	b.WriteString("\n")
+	if len(rep.ResetEvents) == 0 {
+		b.WriteString(resetBaseStyle.Render(consts.TextReportNoResets))
+		b.WriteString("\n")
+	}
+	for _, ev := range rep.ResetEvents {
+		b.WriteString(resetBaseStyle.Render(reportResetLine(ev, rep.Options.Location)))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// renderReportMarkdown renders the report as a GitHub-flavored Markdown table.
+func renderReportMarkdown(rep report.Report) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s\n\n_%s_\n\n", consts.TextReportTitle, reportRange(rep))
+
+	if len(rep.Rows) == 0 {
+		b.WriteString(consts.TextReportEmpty)
+		b.WriteString("\n")
+		return b.String()
+	}
+
+	headers := reportHeaders(rep)
+	fmt.Fprintf(&b, "| %s |\n", strings.Join(headers, " | "))
+	b.WriteString("|---|---|---|---:|---:|---:|---:|\n")
+	for _, r := range rep.Rows {
+		peak := fmt.Sprintf("`%s` %.1f%%", textBar(reportBarWidth, r.Peak/100), r.Peak)
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %d | %d | %d |\n",
+			r.Period, r.Window.Label(), peak, reportDuration(r.AboveThreshold), r.LimitHits, r.Resets, r.Samples)
+	}
+
+	fmt.Fprintf(&b, "\n### %s\n\n", consts.TextReportResetsTitle)
+	if len(rep.ResetEvents) == 0 {
+		fmt.Fprintf(&b, "_%s_\n", consts.TextReportNoResets)
+	}
+	for _, ev := range rep.ResetEvents {
+		fmt.Fprintf(&b, "- %s\n", reportResetLine(ev, rep.Options.Location))
+	}
+	return b.String()
+}
+
+// reportHeaders returns the column headings for report tables.
+func reportHeaders(rep report.Report) []string {
+	return []string{
+		consts.ColPeriod,
+		consts.ColWindow,
+		consts.ColPeak,
+		fmt.Sprintf(consts.ColAboveFmt, rep.Options.Threshold),
+		consts.ColLimitHits,
+		consts.ColResets,
+		consts.ColSamples,
+	}
+}
+
+// reportRange describes the covered time range and options.
+func reportRange(rep report.Report) string {
+	loc := rep.Options.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	return fmt.Sprintf(consts.TextReportRangeFmt,
+		utils.FormatTime(rep.From.In(loc), consts.ReportRangeLayout),
+		utils.FormatTime(rep.To.In(loc), consts.ReportRangeLayout),
+		rep.Options.GroupBy,
+		rep.Options.Threshold)
+}
+
+// reportResetLine formats a single reset event.
+func reportResetLine(ev report.ResetEvent, loc *time.Location) string {
+	if loc == nil {
+		loc = time.Local
+	}
+	return fmt.Sprintf(consts.TextReportResetFmt, utils.FormatTime(ev.At.In(loc), consts.ReportHourLayout), ev.Window.Label(), ev.PeakBefore)
+}
+
+// reportDuration formats time above threshold, using a dash for zero.
+func reportDuration(d time.Duration) string {
+	if d <= 0 {
+		return consts.TextReportNone
+	}
+	return utils.FriendlyDuration(d)
+}
+
+// textBar draws an unstyled bar using block characters, for outputs that
+// cannot carry ANSI colors.
+//
+// Parameters:
+//   - width: total cells for the bar.
+//   - pct: progress fraction [0,1].
+//
+// Returns:
+//   - bar made of filled and light-shade blocks.
+func textBar(width int, pct float64) string {
+	if width <= 0 {
+		return ""
+	}
+	fill := int(math.Round(utils.Clamp(pct, 0, 1) * float64(width)))
+	return strings.Repeat("█", fill) + strings.Repeat("░", width-fill)
+}
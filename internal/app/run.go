@@ -2,6 +2,9 @@ package app
 
 import (
 	"context"
+	"time"
+
+	"claude-monitor/internal/consts"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -12,7 +15,17 @@ import (
 //   - nil on a clean shutdown.
 //   - an error if the Bubble Tea program fails to start or run.
 func Run(ctx context.Context, cfg Config) error {
-	p := tea.NewProgram(initialModel(ctx, cfg), tea.WithAltScreen(), tea.WithContext(ctx))
+	if len(cfg.Themes) > 0 {
+		ApplyTheme(cfg.Themes[0])
+	}
+	if cfg.Samples != nil {
+		_ = cfg.Samples.Prune(time.Now().Add(-consts.SampleRetention))
+	}
+	opts := []tea.ProgramOption{tea.WithAltScreen(), tea.WithContext(ctx)}
+	if !cfg.NoMouse {
+		opts = append(opts, tea.WithMouseCellMotion())
+	}
+	p := tea.NewProgram(initialModel(ctx, cfg), opts...)
 	_, err := p.Run()
 	return err
 }
@@ -0,0 +1,239 @@
+package app
+
+import (
+	"math/rand"
+	"strings"
+	"time"
+
+	"claude-monitor/internal/api"
+)
+
+const (
+	// resetGrace is how long after a window boundary the post-reset fetch
+	// fires, giving the API a moment to roll the window over.
+	resetGrace = 5 * time.Second
+	// nearThresholdMargin widens the "near threshold" band below the
+	// configured threshold.
+	nearThresholdMargin = 10.0
+	// climbEpsilon is the utilization increase (in points) treated as climbing
+	// rather than noise.
+	climbEpsilon = 0.5
+	// idleUtilization is the utilization below which a flat window is idle.
+	idleUtilization = 5.0
+)
+
+// intervalPresets are the base intervals the interval keys step through.
+var intervalPresets = []time.Duration{
+	10 * time.Second,
+	15 * time.Second,
+	30 * time.Second,
+	time.Minute,
+	2 * time.Minute,
+	5 * time.Minute,
+	10 * time.Minute,
+	15 * time.Minute,
+	30 * time.Minute,
+}
+
+// scheduler decides when the next usage fetch should run. It polls faster
+// while utilization is climbing or near the threshold, slower while idle,
+// always lands a fetch just after a window reset, and honors Retry-After.
+type scheduler struct {
+	base      time.Duration
+	minEvery  time.Duration
+	maxEvery  time.Duration
+	threshold float64
+}
+
+// newScheduler builds a scheduler from the config, deriving bounds from the
+// base interval when they are not set explicitly.
+//
+// Params:
+//   - cfg: provides the base interval, bounds, and threshold.
+//
+// Returns:
+//   - a scheduler ready to compute delays.
+func newScheduler(cfg Config) scheduler {
+	s := scheduler{
+		base:      cfg.RefreshEvery,
+		minEvery:  cfg.MinRefresh,
+		maxEvery:  cfg.MaxRefresh,
+		threshold: cfg.Threshold,
+	}
+	if s.minEvery <= 0 {
+		s.minEvery = s.base / 4
+	}
+	if s.maxEvery <= 0 {
+		s.maxEvery = s.base * 8
+	}
+	if s.threshold <= 0 {
+		s.threshold = defaultThreshold
+	}
+	return s
+}
+
+// withBase returns a copy of s polling around base. Bounds derived from the
+// old base are re-derived; explicitly configured bounds are kept.
+//
+// Params:
+//   - cfg: the original config, to tell derived bounds from explicit ones.
+//   - base: new base interval.
+//
+// Returns:
+//   - the adjusted scheduler.
+func (s scheduler) withBase(cfg Config, base time.Duration) scheduler {
+	cfg.RefreshEvery = base
+	return newScheduler(cfg)
+}
+
+// stepInterval returns the next preset above (dir > 0) or below (dir < 0)
+// cur, or cur when there is none in that direction.
+func stepInterval(cur time.Duration, dir int) time.Duration {
+	if dir > 0 {
+		for _, p := range intervalPresets {
+			if p > cur {
+				return p
+			}
+		}
+		return cur
+	}
+	for i := len(intervalPresets) - 1; i >= 0; i-- {
+		if intervalPresets[i] < cur {
+			return intervalPresets[i]
+		}
+	}
+	return cur
+}
+
+// shortDuration formats d like time.Duration.String without zero trailing
+// units ("1m" rather than "1m0s").
+func shortDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// afterSuccess returns the delay before the next fetch following a successful
+// one.
+//
+// Params:
+//   - now: current time.
+//   - prev: previous successful response, or nil.
+//   - cur: response just received.
+//
+// Returns:
+//   - delay bounded by the scheduler's min/max, shortened to land just after
+//     the next reset boundary when one falls inside it.
+func (s scheduler) afterSuccess(now time.Time, prev *api.UsageResponse, cur api.UsageResponse) time.Duration {
+	peak := peakUtilization(cur)
+	climbing := false
+	if prev != nil {
+		climbing = peak-peakUtilization(*prev) > climbEpsilon
+	}
+
+	d := s.base
+	switch {
+	case climbing && peak >= s.threshold-nearThresholdMargin:
+		d = s.base / 4
+	case climbing, peak >= s.threshold-nearThresholdMargin:
+		d = s.base / 2
+	case peak < idleUtilization:
+		d = s.base * 4
+	}
+	d = s.clamp(d)
+
+	if until, ok := nextReset(now, cur); ok && until+resetGrace < d {
+		d = until + resetGrace
+	}
+	return d
+}
+
+// afterError returns the delay before retrying a failed fetch using the
+// exponential backoff from retryInterval, bounded by the scheduler's maximum
+// unless the server asked for a longer Retry-After.
+//
+// Params:
+//   - failures: consecutive failure count (>= 1).
+//   - retryAfter: server-provided Retry-After, or zero.
+//
+// Returns:
+//   - retry delay.
+func (s scheduler) afterError(failures int, retryAfter time.Duration) time.Duration {
+	d := retryInterval(s.base, failures, 0)
+	if d > s.maxEvery {
+		d = s.maxEvery
+	}
+	if d < s.minEvery {
+		d = s.minEvery
+	}
+	if retryAfter > d {
+		d = retryAfter
+	}
+	return d
+}
+
+// clamp bounds d to [minEvery, maxEvery].
+func (s scheduler) clamp(d time.Duration) time.Duration {
+	if d < s.minEvery {
+		return s.minEvery
+	}
+	if d > s.maxEvery {
+		return s.maxEvery
+	}
+	return d
+}
+
+// peakUtilization returns the highest utilization across windows.
+func peakUtilization(u api.UsageResponse) float64 {
+	peak := 0.0
+	for _, w := range []*api.WindowUsage{u.FiveHour, u.SevenDay} {
+		if w != nil && w.Utilization != nil && *w.Utilization > peak {
+			peak = *w.Utilization
+		}
+	}
+	return peak
+}
+
+// nextReset returns the time until the earliest future reset boundary.
+func nextReset(now time.Time, u api.UsageResponse) (time.Duration, bool) {
+	var best time.Duration
+	found := false
+	for _, w := range []*api.WindowUsage{u.FiveHour, u.SevenDay} {
+		if w == nil || w.ResetsAt == nil {
+			continue
+		}
+		until := w.ResetsAt.Sub(now)
+		if until <= 0 {
+			continue
+		}
+		if !found || until < best {
+			best, found = until, true
+		}
+	}
+	return best, found
+}
+
+func retryInterval(base time.Duration, failures int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+	if failures <= 0 || base <= 0 {
+		return base
+	}
+	step := failures
+	if step > 3 {
+		step = 3
+	}
+	backoff := base * time.Duration(1<<step)
+	maxBackoff := base * 8
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	jitter := time.Duration(rand.Int63n(int64(backoff / 5))) // up to +20%
+	return backoff + jitter
+}
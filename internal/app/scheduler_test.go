@@ -0,0 +1,86 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"claude-monitor/internal/api"
+	"claude-monitor/internal/apitest"
+)
+
+func TestRetryIntervalBacksOff(t *testing.T) {
+	base := 10 * time.Second
+	for failures, want := range map[int]time.Duration{
+		1: 20 * time.Second,
+		2: 40 * time.Second,
+		3: 80 * time.Second,
+		6: 80 * time.Second,
+	} {
+		for i := 0; i < 20; i++ {
+			got := retryInterval(base, failures, 0)
+			if got < want || got > want+want/5 {
+				t.Fatalf("retryInterval(%v, %d) = %v, want %v plus up to 20%% jitter", base, failures, got, want)
+			}
+		}
+	}
+	if got := retryInterval(base, 0, 0); got != base {
+		t.Fatalf("no failures: got %v, want %v", got, base)
+	}
+	if got := retryInterval(base, 2, 90*time.Second); got != 90*time.Second {
+		t.Fatalf("Retry-After: got %v, want 90s", got)
+	}
+}
+
+func TestAfterErrorBounds(t *testing.T) {
+	s := newScheduler(Config{RefreshEvery: 30 * time.Second, MaxRefresh: time.Minute})
+	if got := s.afterError(3, 0); got != time.Minute {
+		t.Fatalf("backoff past max: got %v, want 1m", got)
+	}
+	if got := s.afterError(1, 5*time.Minute); got != 5*time.Minute {
+		t.Fatalf("Retry-After beyond max: got %v, want 5m", got)
+	}
+}
+
+// TestSchedulerAgainstFakeAPI drives fetches and delays the way Poll does
+// through a scripted rate limit, outage, and recovery.
+func TestSchedulerAgainstFakeAPI(t *testing.T) {
+	srv := apitest.NewServer(
+		apitest.RateLimited(2*time.Minute),
+		apitest.ServerErrors(http.StatusServiceUnavailable, 2),
+		apitest.Ramp(40, 90, 2, 20, 3*time.Hour)[0],
+		apitest.Ramp(40, 90, 2, 20, 3*time.Hour)[1],
+	)
+	defer srv.Close()
+	cfg := Config{Token: "test", RefreshEvery: 30 * time.Second, HTTPClient: srv.Client(time.Second), BetaHeader: "beta"}
+	sched := newScheduler(cfg)
+
+	var delays []time.Duration
+	var prev *api.UsageResponse
+	failures := 0
+	for i := 0; i < 5; i++ {
+		data, _, err := fetchUsage(context.Background(), cfg)
+		if err != nil {
+			failures++
+			delays = append(delays, sched.afterError(failures, retryAfterOf(err)))
+			continue
+		}
+		failures = 0
+		delays = append(delays, sched.afterSuccess(time.Now(), prev, data))
+		prev = &data
+	}
+
+	if delays[0] != 2*time.Minute {
+		t.Fatalf("rate limit: delay %v, want the 2m Retry-After", delays[0])
+	}
+	if delays[2] <= delays[1] || delays[2] != sched.maxEvery {
+		t.Fatalf("server errors: delays %v, want growth up to %v", delays[1:3], sched.maxEvery)
+	}
+	if delays[3] != 30*time.Second {
+		t.Fatalf("first success: delay %v, want the 30s base", delays[3])
+	}
+	if delays[4] != sched.minEvery {
+		t.Fatalf("climb near the threshold: delay %v, want %v", delays[4], sched.minEvery)
+	}
+}
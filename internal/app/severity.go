@@ -0,0 +1,153 @@
+package app
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"claude-monitor/internal/consts"
+
+	"github.com/charmbracelet/lipgloss"
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// defaultWarnBand is where the warn band starts when only the threshold is
+// known.
+const defaultWarnBand = 50.0
+
+// Bands splits utilization into ok, warn and crit severity bands used to
+// color bars, values and the chart border.
+type Bands struct {
+	// Warn is where the warn band starts.
+	Warn float64
+	// Crit is where the crit band starts; zero disables bands and bars use
+	// the theme accent.
+	Crit float64
+	// Gradient colors each filled cell by its own position instead of the
+	// whole fill by the current value.
+	Gradient bool
+}
+
+// severity ranks a utilization value against Bands.
+type severity int
+
+const (
+	severityNone severity = iota
+	severityOK
+	severityWarn
+	severityCrit
+)
+
+// ParseBands parses a "-bands" value: "warn,crit" percentages, "none" to
+// disable bands, or empty to start warn at 50% and crit at the threshold.
+//
+// Parameters:
+//   - spec: flag value.
+//   - threshold: the "near the limit" percentage; zero uses the default.
+//
+// Returns:
+//   - the bands, without Gradient set.
+//   - error for malformed or out-of-order values.
+func ParseBands(spec string, threshold float64) (Bands, error) {
+	spec = strings.TrimSpace(spec)
+	switch spec {
+	case consts.BandsNone:
+		return Bands{}, nil
+	case "":
+		if threshold <= 0 {
+			threshold = defaultThreshold
+		}
+		return Bands{Warn: math.Min(defaultWarnBand, threshold), Crit: threshold}, nil
+	}
+	parts := strings.Split(spec, ",")
+	if len(parts) != 2 {
+		return Bands{}, fmt.Errorf(consts.ErrBandsFmt, spec)
+	}
+	warn, errWarn := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	crit, errCrit := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if errWarn != nil || errCrit != nil || warn < 0 || crit <= 0 || warn > crit || crit > 100 {
+		return Bands{}, fmt.Errorf(consts.ErrBandsFmt, spec)
+	}
+	return Bands{Warn: warn, Crit: crit}, nil
+}
+
+// enabled reports whether severity colors apply.
+func (b Bands) enabled() bool {
+	return b.Crit > 0
+}
+
+// level classifies pct, or severityNone when bands are disabled.
+func (b Bands) level(pct float64) severity {
+	switch {
+	case !b.enabled():
+		return severityNone
+	case pct >= b.Crit:
+		return severityCrit
+	case pct >= b.Warn:
+		return severityWarn
+	default:
+		return severityOK
+	}
+}
+
+// severityColor returns the theme color of a band, or the accent when
+// bands are disabled.
+func severityColor(s severity) lipgloss.TerminalColor {
+	switch s {
+	case severityOK:
+		return paletteOK
+	case severityWarn:
+		return paletteWarn
+	case severityCrit:
+		return paletteCrit
+	}
+	return paletteAccent
+}
+
+// gradientColor returns the fill color of a cell at position pos (0–100):
+// ok at 0, warn at the warn band, crit from the crit band up, blended in
+// between. Colors that are not hex values fall back to the stepped band
+// color.
+func (b Bands) gradientColor(pos float64) lipgloss.TerminalColor {
+	type stop struct {
+		at    float64
+		color lipgloss.TerminalColor
+	}
+	stops := []stop{{0, paletteOK}, {b.Warn, paletteWarn}, {b.Crit, paletteCrit}}
+	if pos >= b.Crit {
+		return paletteCrit
+	}
+	for i := 1; i < len(stops); i++ {
+		lo, hi := stops[i-1], stops[i]
+		if pos >= hi.at {
+			continue
+		}
+		from, okFrom := resolveColor(lo.color)
+		to, okTo := resolveColor(hi.color)
+		if !okFrom || !okTo || hi.at <= lo.at {
+			return severityColor(b.level(pos))
+		}
+		return lipgloss.Color(from.BlendLab(to, (pos-lo.at)/(hi.at-lo.at)).Clamped().Hex())
+	}
+	return paletteCrit
+}
+
+// resolveColor converts a palette color to RGB for the current background.
+func resolveColor(c lipgloss.TerminalColor) (colorful.Color, bool) {
+	var hex string
+	switch c := c.(type) {
+	case lipgloss.AdaptiveColor:
+		hex = c.Dark
+		if !lipgloss.HasDarkBackground() {
+			hex = c.Light
+		}
+	case lipgloss.Color:
+		hex = string(c)
+	}
+	if len(hex) == 4 {
+		hex = "#" + strings.Repeat(hex[1:2], 2) + strings.Repeat(hex[2:3], 2) + strings.Repeat(hex[3:4], 2)
+	}
+	col, err := colorful.Hex(hex)
+	return col, err == nil
+}
@@ -0,0 +1,27 @@
+package app
+
+import "testing"
+
+func TestParseBands(t *testing.T) {
+	tests := []struct {
+		spec      string
+		threshold float64
+		want      Bands
+		wantErr   bool
+	}{
+		{spec: "", threshold: 0, want: Bands{Warn: 50, Crit: 80}},
+		{spec: "", threshold: 40, want: Bands{Warn: 40, Crit: 40}},
+		{spec: "none", threshold: 80, want: Bands{}},
+		{spec: " 60, 90 ", threshold: 80, want: Bands{Warn: 60, Crit: 90}},
+		{spec: "90,60", wantErr: true},
+		{spec: "60,101", wantErr: true},
+		{spec: "60", wantErr: true},
+		{spec: "a,b", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseBands(tt.spec, tt.threshold)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseBands(%q, %v) = %+v, %v; want %+v, error %v", tt.spec, tt.threshold, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
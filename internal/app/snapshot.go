@@ -0,0 +1,148 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/screen"
+	"claude-monitor/internal/store"
+	"claude-monitor/internal/utils"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// SnapshotOptions controls how a dashboard frame is rendered to a file.
+type SnapshotOptions struct {
+	// Format is consts.FormatText, FormatANSI, FormatHTML or FormatSVG.
+	Format string
+	// Width is the terminal width the frame is laid out for.
+	Width int
+	// At is the clock the frame is rendered at; zero uses the sample time.
+	At time.Time
+	// Profile is the color profile name (see ParseColorProfile); empty
+	// picks truecolor, or no color for text output.
+	Profile string
+	// Light renders for a light terminal background instead of a dark one.
+	Light bool
+	// Bands colors bars and values by severity (zero disables).
+	Bands Bands
+	// Layout picks the dashboard layout; auto is full since a snapshot has
+	// no height.
+	Layout LayoutMode
+}
+
+// Validate checks the format, width, and color profile.
+//
+// Returns:
+//   - nil when all options are usable.
+//   - an error naming the first invalid value.
+func (o SnapshotOptions) Validate() error {
+	switch o.Format {
+	case consts.FormatText, consts.FormatANSI, consts.FormatHTML, consts.FormatSVG:
+	default:
+		return fmt.Errorf(consts.ErrFormatFmt, o.Format)
+	}
+	if o.Width < minContainerWidth {
+		return fmt.Errorf(consts.ErrSnapshotWidthFmt, minContainerWidth)
+	}
+	if o.Profile != "" {
+		if _, err := ParseColorProfile(o.Profile); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseColorProfile maps a profile name to its termenv profile.
+//
+// Parameters:
+//   - name: consts.ColorProfileASCII, ColorProfileANSI, ColorProfileANSI256
+//     or ColorProfileTrueColor.
+//
+// Returns:
+//   - the matching profile.
+//   - error for unknown names.
+func ParseColorProfile(name string) (termenv.Profile, error) {
+	switch name {
+	case consts.ColorProfileASCII:
+		return termenv.Ascii, nil
+	case consts.ColorProfileANSI:
+		return termenv.ANSI, nil
+	case consts.ColorProfileANSI256:
+		return termenv.ANSI256, nil
+	case consts.ColorProfileTrueColor:
+		return termenv.TrueColor, nil
+	}
+	return termenv.Ascii, fmt.Errorf(consts.ErrColorProfileFmt, name)
+}
+
+// RenderSnapshot renders the dashboard frame the TUI would show for s.
+// The frame is produced by the same View code at a fixed clock and color
+// profile, so equal inputs give byte-identical output.
+//
+// Unless a zone was chosen with SetResetFormat, reset times are shown in the
+// offset of opt.At, or in UTC, rather than the machine's zone.
+//
+// The color profile, background and reset zone are applied to
+// process-wide state, so this is meant for one-shot commands rather than
+// alongside a running TUI.
+//
+// Parameters:
+//   - s: sample to show as the latest fetch.
+//   - opt: validated output options.
+//
+// Returns:
+//   - the rendered frame in the requested format, ending in a newline.
+//   - error for invalid options.
+func RenderSnapshot(s store.Sample, opt SnapshotOptions) (string, error) {
+	if err := opt.Validate(); err != nil {
+		return "", err
+	}
+	profile := termenv.TrueColor
+	if opt.Profile != "" {
+		profile, _ = ParseColorProfile(opt.Profile)
+	} else if opt.Format == consts.FormatText {
+		profile = termenv.Ascii
+	}
+	lipgloss.SetColorProfile(profile)
+	lipgloss.SetHasDarkBackground(!opt.Light)
+
+	if f := utils.CurrentResetFormat(); f.Location == nil {
+		f.Location = time.UTC
+		if !opt.At.IsZero() {
+			f.Location = opt.At.Location()
+		}
+		utils.SetResetFormat(f)
+	}
+	at := opt.At
+	if at.IsZero() {
+		at = s.Time
+	}
+	m := model{
+		cfg:         Config{Bands: opt.Bands, Layout: opt.Layout},
+		width:       opt.Width,
+		usage:       &s.UsageResponse,
+		lastUpdated: s.Time,
+		sp:          newSpinner(),
+		clock:       func() time.Time { return at },
+		keys:        DefaultKeyMap(),
+	}
+	frame := m.View()
+
+	theme := screen.Dark
+	if opt.Light {
+		theme = screen.Light
+	}
+	switch opt.Format {
+	case consts.FormatANSI:
+		return frame + "\n", nil
+	case consts.FormatHTML:
+		return screen.Parse(frame).HTML(theme), nil
+	case consts.FormatSVG:
+		return screen.Parse(frame).SVG(theme), nil
+	default:
+		return screen.Parse(frame).Text(), nil
+	}
+}
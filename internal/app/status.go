@@ -0,0 +1,206 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// FetchStatus fetches usage once, recording it like any other poll.
+//
+// Parameters:
+//   - ctx: parent context; the request uses the configured timeout.
+//   - cfg: validated Config.
+//
+// Returns:
+//   - the sample stamped with the fetch time.
+//   - the fetch error, formatted like the TUI shows it.
+func FetchStatus(ctx context.Context, cfg Config) (store.Sample, error) {
+	reqCtx, cancel := requestContext(ctx, cfg)
+	defer cancel()
+	data, _, err := fetchAndPersist(reqCtx, cfg)
+	if err != nil {
+		return store.Sample{}, errors.New(formatError(err))
+	}
+	return store.Sample{Time: time.Now(), UsageResponse: data}, nil
+}
+
+// RenderStatus formats a sample as the dashboard's bars or as JSON.
+//
+// Parameters:
+//   - s: sample to render.
+//   - width: columns available for text output.
+//   - format: consts.FormatText or consts.FormatJSON.
+//   - bands: severity bands for text output (zero disables).
+//
+// Returns:
+//   - rendered output ending in a newline.
+//   - error for unknown formats.
+func RenderStatus(s store.Sample, width int, format string, bands Bands) (string, error) {
+	switch format {
+	case consts.FormatText:
+		rows := buildRows(s.UsageResponse, time.Now())
+		if len(rows) == 0 {
+			return consts.TextNoData + "\n", nil
+		}
+		return strings.TrimRight(renderBars(rows, width, bands), "\n") + "\n", nil
+	case consts.FormatJSON:
+		out, err := json.Marshal(s)
+		if err != nil {
+			return "", err
+		}
+		return string(out) + "\n", nil
+	}
+	return "", fmt.Errorf(consts.ErrFormatFmt, format)
+}
+
+// WatchOptions controls how RunWatch prints each poll.
+type WatchOptions struct {
+	// Mode is consts.WatchModeLog (one line per poll) or
+	// consts.WatchModeBlock (a compact dashboard block per poll).
+	Mode string
+	// Width is the block width in columns.
+	Width int
+	// Rewrite redraws each block over the previous one instead of appending
+	// it; only meaningful when w is a terminal.
+	Rewrite bool
+	// Bands colors block bars and values by severity (zero disables).
+	Bands Bands
+}
+
+// Validate checks the mode and width.
+func (o WatchOptions) Validate() error {
+	switch o.Mode {
+	case consts.WatchModeLog:
+	case consts.WatchModeBlock:
+		if o.Width < minContainerWidth {
+			return fmt.Errorf(consts.ErrSnapshotWidthFmt, minContainerWidth)
+		}
+	default:
+		return fmt.Errorf(consts.ErrWatchModeFmt, o.Mode)
+	}
+	return nil
+}
+
+// RunWatch polls on the TUI's schedule and writes a timestamped line or
+// block per poll to w until ctx is canceled. Nothing is cleared on exit, so
+// the output stays in the scrollback.
+//
+// Parameters:
+//   - ctx: stops the loop when canceled.
+//   - cfg: validated Config.
+//   - w: destination, usually stdout.
+//   - opt: validated output options.
+//
+// Returns:
+//   - write errors; nil when ctx is canceled.
+func RunWatch(ctx context.Context, cfg Config, w io.Writer, opt WatchOptions) error {
+	if len(cfg.Themes) > 0 {
+		ApplyTheme(cfg.Themes[0])
+	}
+	var (
+		writeErr  error
+		lastGood  *store.Sample
+		prevLines int
+	)
+	pollCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	err := Poll(pollCtx, cfg, func(res PollResult) {
+		var out string
+		switch opt.Mode {
+		case consts.WatchModeBlock:
+			if res.Err == nil {
+				lastGood = &store.Sample{Time: res.Time, UsageResponse: res.Usage}
+			}
+			block := renderWatchBlock(res, lastGood, opt)
+			switch {
+			case opt.Rewrite && prevLines > 0:
+				// Move up over the previous block and clear it.
+				out = fmt.Sprintf(consts.WatchRewindFmt, prevLines)
+			case prevLines > 0:
+				out = "\n"
+			}
+			out += block + "\n"
+			prevLines = lipgloss.Height(block)
+		default:
+			out = watchLine(res, cfg.Threshold) + "\n"
+		}
+		if _, err := io.WriteString(w, out); err != nil {
+			writeErr = err
+			cancel()
+		}
+	})
+	if writeErr != nil {
+		return writeErr
+	}
+	return err
+}
+
+// watchLine formats one poll as "time  5h 42% · 7d 71%", each window
+// followed by the time until it resets, or the error.
+func watchLine(res PollResult, threshold float64) string {
+	line := res.Time.Format(consts.NextPollLayout) + "  "
+	if res.Err != nil {
+		return line + fmt.Sprintf(consts.TextErrorFmt, formatError(res.Err))
+	}
+	return line + usageSummary(res.Usage, res.Time, barOptions{remaining: true, threshold: threshold})
+}
+
+// renderWatchBlock draws a poll as a timestamp line followed by the compact
+// bars. A failed poll adds the error and shows the last good sample dimmed.
+//
+// Parameters:
+//   - res: the poll to render.
+//   - last: the most recent successful sample, or nil.
+//   - opt: width and bands.
+//
+// Returns:
+//   - the block without a trailing newline.
+func renderWatchBlock(res PollResult, last *store.Sample, opt WatchOptions) string {
+	sep := separatorStyle.Render(consts.TextSeparatorDot)
+	header := statusStyle.Render(res.Time.Format(consts.NextPollLayout))
+	if res.Next > 0 {
+		next := fmt.Sprintf(consts.TextNextPollFmt, res.Time.Add(res.Next).Format(consts.NextPollLayout))
+		header += sep + statusStyle.Render(next)
+	}
+	lines := []string{header}
+	if res.Err != nil {
+		lines = append(lines, errorBannerStyle.MaxWidth(opt.Width).
+			Render(fmt.Sprintf(consts.TextErrorBannerFmt, formatError(res.Err))))
+	}
+	if last == nil {
+		return strings.Join(lines, "\n")
+	}
+	rows := buildRows(last.UsageResponse, res.Time)
+	if len(rows) == 0 {
+		return strings.Join(append(lines, consts.TextNoData), "\n")
+	}
+	barOpt := barRenderOptions{
+		labelStyle:    labelBaseStyle,
+		valueStyle:    valueBaseStyle,
+		remainStyle:   &remainBaseStyle,
+		barFillStyle:  barFillStyle,
+		barEmptyStyle: barEmptyStyle,
+		bands:         opt.Bands,
+	}
+	if res.Err != nil {
+		barOpt = barRenderOptions{
+			labelStyle:    skeletonLabelStyle,
+			valueStyle:    valueBaseStyle.Foreground(paletteMuted),
+			remainStyle:   ptrStyle(remainBaseStyle.Foreground(paletteMuted)),
+			barFillStyle:  skeletonBarFillStyle,
+			barEmptyStyle: barEmptyStyle,
+		}
+	}
+	lines = append(lines, renderCompactRows(rows, opt.Width, barOpt))
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,337 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"claude-monitor/internal/api"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
+	"claude-monitor/internal/utils"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// barSegment is one window's text and color in a status bar line.
+type barSegment struct {
+	label   string
+	text    string
+	percent float64
+	color   lipgloss.TerminalColor
+	detail  string
+}
+
+// barOptions controls how segments are built for status bar outputs.
+type barOptions struct {
+	maxAge    time.Duration
+	remaining bool
+	threshold float64
+	// bands picks segment colors by severity instead of accent/error at the
+	// threshold when enabled.
+	bands Bands
+}
+
+// barSegments builds one segment per window with data, colored by threshold
+// and dimmed when the sample is stale or the window has already reset.
+//
+// Parameters:
+//   - snap: sample to render.
+//   - now: reference time for staleness and remaining time.
+//   - opt: staleness, remaining-time, and threshold options.
+//
+// Returns:
+//   - segments in display order and whether the sample is stale.
+func barSegments(snap store.Sample, now time.Time, opt barOptions) ([]barSegment, bool) {
+	threshold := opt.threshold
+	if threshold <= 0 {
+		threshold = defaultThreshold
+	}
+	stale := opt.maxAge > 0 && now.Sub(snap.Time) > opt.maxAge
+
+	windows := []struct {
+		short string
+		label string
+		win   *api.WindowUsage
+	}{
+		{short: consts.BarLabelFiveHour, label: consts.LabelCurrent, win: snap.FiveHour},
+		{short: consts.BarLabelSevenDay, label: consts.LabelWeekly, win: snap.SevenDay},
+	}
+
+	segments := make([]barSegment, 0, len(windows))
+	for _, w := range windows {
+		if w.win == nil || w.win.Utilization == nil {
+			continue
+		}
+		pct := utils.Clamp(*w.win.Utilization, 0, 100)
+		seg := barSegment{
+			label:   w.label,
+			text:    fmt.Sprintf(consts.BarSegmentFmt, w.short, pct),
+			percent: pct,
+			color:   paletteAccent,
+			detail:  strings.TrimSpace(fmt.Sprintf(consts.PercentFmt, pct)),
+		}
+		switch {
+		case opt.bands.enabled():
+			seg.color = severityColor(opt.bands.level(pct))
+		case pct >= threshold:
+			seg.color = paletteError
+		}
+		if w.win.ResetsAt != nil {
+			parts := []string{seg.detail}
+			reset, remain := utils.FormatResetAt(*w.win.ResetsAt, now)
+			for _, p := range []string{reset, remain} {
+				if p != "" {
+					parts = append(parts, p)
+				}
+			}
+			seg.detail = strings.Join(parts, consts.TextSeparatorDot)
+			left := w.win.ResetsAt.Sub(now)
+			if left <= 0 {
+				seg.color = paletteMuted
+			} else if opt.remaining {
+				seg.text += " " + utils.FriendlyDuration(left)
+			}
+		}
+		if stale {
+			seg.color = paletteMuted
+		}
+		segments = append(segments, seg)
+	}
+	return segments, stale
+}
+
+// barSeverity classifies the highest utilization for status bar classes.
+func barSeverity(segments []barSegment, threshold float64) string {
+	if threshold <= 0 {
+		threshold = defaultThreshold
+	}
+	peak := 0.0
+	for _, s := range segments {
+		if s.percent > peak {
+			peak = s.percent
+		}
+	}
+	switch {
+	case peak >= 100:
+		return consts.BarClassCritical
+	case peak >= threshold:
+		return consts.BarClassWarning
+	default:
+		return consts.BarClassNormal
+	}
+}
+
+// barState is what a status bar emitter renders after each poll: the last
+// good sample (if any) and the most recent error.
+type barState struct {
+	sample *store.Sample
+	err    error
+	now    time.Time
+}
+
+// barEmitter formats a barState as one output line (without newline).
+type barEmitter func(st barState, opt barOptions) string
+
+// StatusBarOptions controls the streaming status bar output.
+type StatusBarOptions struct {
+	// MaxAge marks the sample as stale once it is older than this; zero
+	// never marks it stale.
+	MaxAge time.Duration
+	// Remaining appends the time left until each window resets.
+	Remaining bool
+}
+
+// RunStatusBar polls usage on the TUI's schedule and writes one line per poll
+// to w in the given status bar format. After a failed fetch the last good
+// sample stays visible, marked stale, with the error in the tooltip.
+//
+// Parameters:
+//   - ctx: stops the loop when canceled.
+//   - cfg: validated Config.
+//   - format: consts.FormatWaybar, FormatI3Blocks, FormatI3Bar, or FormatPolybar.
+//   - w: destination, usually stdout.
+//   - so: staleness and remaining-time options.
+//
+// Returns:
+//   - error for unknown formats or write failures; nil when ctx is canceled.
+func RunStatusBar(ctx context.Context, cfg Config, format string, w io.Writer, so StatusBarOptions) error {
+	var emit barEmitter
+	switch format {
+	case consts.FormatWaybar:
+		emit = waybarLine
+	case consts.FormatI3Blocks:
+		emit = i3blocksLine
+	case consts.FormatI3Bar:
+		emit = i3barLine
+		if _, err := io.WriteString(w, "{\"version\":1}\n[\n"); err != nil {
+			return err
+		}
+	case consts.FormatPolybar:
+		emit = polybarLine
+	default:
+		return fmt.Errorf(consts.ErrFormatFmt, format)
+	}
+
+	opt := barOptions{
+		maxAge:    so.MaxAge,
+		remaining: so.Remaining,
+		threshold: cfg.Threshold,
+		bands:     cfg.Bands,
+	}
+	var st barState
+	if cfg.Snapshot != nil {
+		if snap, err := cfg.Snapshot.Load(); err == nil {
+			st.sample = &snap
+		}
+	}
+
+	var writeErr error
+	pollCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	err := Poll(pollCtx, cfg, func(res PollResult) {
+		st.now = res.Time
+		st.err = res.Err
+		if res.Err == nil {
+			st.sample = &store.Sample{Time: res.Time, UsageResponse: res.Usage}
+		}
+		if _, err := io.WriteString(w, emit(st, opt)+"\n"); err != nil {
+			writeErr = err
+			cancel()
+		}
+	})
+	if writeErr != nil {
+		return writeErr
+	}
+	return err
+}
+
+// waybarLine renders a Waybar custom module JSON object.
+func waybarLine(st barState, opt barOptions) string {
+	out := struct {
+		Text       string `json:"text"`
+		Tooltip    string `json:"tooltip"`
+		Class      string `json:"class"`
+		Percentage int    `json:"percentage"`
+	}{}
+
+	segments, tooltip, class := barSummary(st, opt)
+	texts := make([]string, 0, len(segments))
+	for _, s := range segments {
+		texts = append(texts, s.text)
+		if int(s.percent+0.5) > out.Percentage {
+			out.Percentage = int(s.percent + 0.5)
+		}
+	}
+	out.Text = strings.Join(texts, consts.TextSeparatorDot)
+	if out.Text == "" {
+		out.Text = consts.TextBarNoData
+	}
+	out.Tooltip = tooltip
+	out.Class = class
+	data, _ := json.Marshal(out)
+	return string(data)
+}
+
+// i3Block is a single block in the i3bar protocol.
+type i3Block struct {
+	Name      string `json:"name"`
+	FullText  string `json:"full_text"`
+	ShortText string `json:"short_text,omitempty"`
+	Color     string `json:"color,omitempty"`
+	Urgent    bool   `json:"urgent,omitempty"`
+}
+
+// i3block builds the block shared by the i3blocks and i3bar emitters. The
+// block takes the color of its most utilized window.
+func i3block(st barState, opt barOptions) i3Block {
+	segments, _, class := barSummary(st, opt)
+	block := i3Block{Name: consts.AppDirName, FullText: consts.TextBarNoData}
+	if len(segments) == 0 {
+		block.Color = colorHex(paletteMuted)
+		return block
+	}
+	texts := make([]string, 0, len(segments))
+	color, peak := segments[0].color, segments[0].percent
+	for _, s := range segments {
+		texts = append(texts, s.text)
+		if s.percent > peak {
+			color, peak = s.color, s.percent
+		}
+	}
+	block.FullText = strings.Join(texts, consts.TextSeparatorDot)
+	block.ShortText = segments[0].text
+	block.Color = colorHex(color)
+	block.Urgent = class == consts.BarClassCritical
+	return block
+}
+
+// i3blocksLine renders one JSON block per line for i3blocks' format=json.
+func i3blocksLine(st barState, opt barOptions) string {
+	data, _ := json.Marshal(i3block(st, opt))
+	return string(data)
+}
+
+// i3barLine renders one status line of the i3bar protocol (an array of
+// blocks followed by a comma, as the infinite array requires).
+func i3barLine(st barState, opt barOptions) string {
+	data, _ := json.Marshal([]i3Block{i3block(st, opt)})
+	return string(data) + ","
+}
+
+// polybarLine renders polybar formatting tags for a tail=true script module.
+func polybarLine(st barState, opt barOptions) string {
+	segments, _, _ := barSummary(st, opt)
+	if len(segments) == 0 {
+		return polybarSegment(paletteMuted, consts.TextBarNoData)
+	}
+	parts := make([]string, 0, len(segments))
+	for _, s := range segments {
+		parts = append(parts, polybarSegment(s.color, s.text))
+	}
+	return strings.Join(parts, " ")
+}
+
+// polybarSegment wraps text in a polybar foreground tag when color is set.
+func polybarSegment(color lipgloss.TerminalColor, text string) string {
+	hex := colorHex(color)
+	if hex == "" {
+		return text
+	}
+	return fmt.Sprintf("%%{F%s}%s%%{F-}", hex, text)
+}
+
+// barSummary derives segments, a multi-line tooltip, and a CSS-style class
+// from the emitter state. Errors mark the output stale and lead the tooltip.
+func barSummary(st barState, opt barOptions) ([]barSegment, string, string) {
+	var segments []barSegment
+	stale := false
+	if st.sample != nil {
+		segments, stale = barSegments(*st.sample, st.now, opt)
+	}
+	lines := make([]string, 0, len(segments)+2)
+	if st.err != nil {
+		lines = append(lines, fmt.Sprintf(consts.TextErrorBannerFmt, formatError(st.err)))
+	}
+	for _, s := range segments {
+		lines = append(lines, s.label+": "+s.detail)
+	}
+	if st.sample != nil {
+		lines = append(lines, utils.HumanTime(st.sample.Time))
+	}
+
+	class := barSeverity(segments, opt.threshold)
+	switch {
+	case st.err != nil && st.sample == nil:
+		class = consts.BarClassError
+	case st.err != nil || stale:
+		class = consts.BarClassStale
+		for i := range segments {
+			segments[i].color = paletteMuted
+		}
+	}
+	return segments, strings.Join(lines, "\n"), class
+}
@@ -2,18 +2,27 @@ package app
 
 import (
 	"os"
-	"strings"
 
-	"claude-monitor/internal/consts"
+	"claude-monitor/internal/theme"
 
 	"github.com/charmbracelet/lipgloss"
 )
 
 var (
-	paletteMuted    lipgloss.TerminalColor = consts.ColorMuted
-	paletteAccent   lipgloss.TerminalColor = consts.ColorAccent
-	paletteAccentHi lipgloss.TerminalColor = consts.ColorAccentHi
-	paletteError    lipgloss.TerminalColor = consts.ColorError
+	paletteMuted    lipgloss.TerminalColor
+	paletteTrack    lipgloss.TerminalColor
+	paletteAccent   lipgloss.TerminalColor
+	paletteAccentHi lipgloss.TerminalColor
+	paletteError    lipgloss.TerminalColor
+	paletteText     lipgloss.TerminalColor
+	paletteOnAccent lipgloss.TerminalColor
+	paletteOK       lipgloss.TerminalColor
+	paletteWarn     lipgloss.TerminalColor
+	paletteCrit     lipgloss.TerminalColor
+	boxBorder       lipgloss.Border
+
+	// activeTheme is the theme the styles were last built from.
+	activeTheme theme.Theme
 
 	pageStyle             lipgloss.Style
 	chartBoxStyle         lipgloss.Style
@@ -42,41 +51,64 @@ var (
 	skeletonBarFillStyle  lipgloss.Style
 	skeletonBarEmptyStyle lipgloss.Style
 	skeletonMetaStyle     lipgloss.Style
+	chartCurrentStyle     lipgloss.Style
+	chartWeeklyStyle      lipgloss.Style
+	chartResetStyle       lipgloss.Style
+	chartAxisStyle        lipgloss.Style
+	staleBadgeStyle       lipgloss.Style
+	pausedBadgeStyle      lipgloss.Style
+	errorBannerStyle      lipgloss.Style
+	detailKeyStyle        lipgloss.Style
+	detailValueStyle      lipgloss.Style
 )
 
 func init() {
-	applyThemeOverrides()
-	initStyles()
+	ApplyTheme(theme.Default())
 }
 
-func applyThemeOverrides() {
+// ApplyTheme switches the palette to t and rebuilds every style. NO_COLOR
+// still wins over the theme's colors.
+//
+// Parameters:
+//   - t: theme to apply.
+func ApplyTheme(t theme.Theme) {
+	activeTheme = t
+	paletteMuted = t.Muted
+	paletteTrack = t.Track
+	paletteAccent = t.Accent
+	paletteAccentHi = t.AccentHi
+	paletteError = t.Error
+	paletteText = t.Text
+	paletteOnAccent = t.OnAccent
+	paletteOK = t.OK
+	paletteWarn = t.Warn
+	paletteCrit = t.Crit
+	boxBorder = t.BorderStyle()
 	if _, ok := os.LookupEnv("NO_COLOR"); ok {
 		blank := lipgloss.Color("")
 		paletteMuted = blank
+		paletteTrack = blank
 		paletteAccent = blank
 		paletteAccentHi = blank
 		paletteError = blank
-		return
-	}
-	if v, ok := os.LookupEnv("CLAUDE_MONITOR_HIGH_CONTRAST"); ok && isTruthy(v) {
-		paletteMuted = lipgloss.AdaptiveColor{Light: "#8a8f98", Dark: "#c3c7cf"}
-		paletteAccent = lipgloss.AdaptiveColor{Light: "#ff6b3d", Dark: "#ff8a50"}
-		paletteAccentHi = lipgloss.AdaptiveColor{Light: "#ffd7c2", Dark: "#ffe1cf"}
-		paletteError = lipgloss.AdaptiveColor{Light: "#ff4d4f", Dark: "#ff7b84"}
+		paletteText = blank
+		paletteOnAccent = blank
+		paletteOK = blank
+		paletteWarn = blank
+		paletteCrit = blank
 	}
+	initStyles()
+	resetRenderCaches()
 }
 
-func isTruthy(v string) bool {
-	v = strings.TrimSpace(strings.ToLower(v))
-	return v == "1" || v == "true" || v == "yes" || v == "on"
-}
-
+// initStyles builds every style from the current palette. ApplyTheme calls
+// it again whenever the theme changes.
 func initStyles() {
 	pageStyle = lipgloss.NewStyle().
 		Padding(1, 2)
 
 	chartBoxStyle = lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(boxBorder).
 		BorderForeground(paletteAccent).
 		Padding(1, 2)
 
@@ -86,7 +118,7 @@ func initStyles() {
 		MarginRight(2)
 
 	headerStyle = lipgloss.NewStyle().
-		Foreground(consts.ColorWhite).
+		Foreground(paletteOnAccent).
 		Background(paletteAccent).
 		Padding(1, 3).
 		MarginBottom(1).
@@ -97,7 +129,7 @@ func initStyles() {
 		Italic(true)
 
 	labelBaseStyle = lipgloss.NewStyle().
-		Foreground(consts.ColorWhite).
+		Foreground(paletteText).
 		Bold(true)
 
 	resetBaseStyle = lipgloss.NewStyle().
@@ -108,7 +140,7 @@ func initStyles() {
 		Foreground(paletteAccentHi)
 
 	valueBaseStyle = lipgloss.NewStyle().
-		Foreground(consts.ColorWhite).
+		Foreground(paletteText).
 		Bold(true)
 
 	spinnerStyle = lipgloss.NewStyle().Foreground(paletteAccent)
@@ -118,10 +150,10 @@ func initStyles() {
 	barSeparatorStyle = lipgloss.NewStyle().Width(1)
 	barFillStyle = lipgloss.NewStyle().
 		Background(paletteAccent).
-		Foreground(consts.ColorWhite)
+		Foreground(paletteOnAccent)
 	barEmptyStyle = lipgloss.NewStyle().
-		Background(consts.ColorTrack).
-		Foreground(consts.ColorWhite)
+		Background(paletteTrack).
+		Foreground(paletteOnAccent)
 
 	metaBaseStyle = lipgloss.NewStyle()
 
@@ -143,7 +175,7 @@ func initStyles() {
 
 	errorBoxStyleBase = lipgloss.NewStyle().
 		Foreground(paletteError).
-		Border(lipgloss.RoundedBorder()).
+		Border(boxBorder).
 		BorderForeground(paletteError).
 		Padding(0, 1)
 
@@ -153,8 +185,28 @@ func initStyles() {
 		Foreground(paletteMuted).
 		Bold(true)
 	skeletonBarFillStyle = lipgloss.NewStyle().Background(paletteMuted)
-	skeletonBarEmptyStyle = lipgloss.NewStyle().Background(consts.ColorTrack)
+	skeletonBarEmptyStyle = lipgloss.NewStyle().Background(paletteTrack)
 	skeletonMetaStyle = lipgloss.NewStyle().
 		Foreground(paletteMuted).
-		Background(consts.ColorTrack)
+		Background(paletteTrack)
+
+	chartCurrentStyle = lipgloss.NewStyle().Foreground(paletteAccent)
+	chartWeeklyStyle = lipgloss.NewStyle().Foreground(paletteAccentHi)
+	chartResetStyle = lipgloss.NewStyle().Foreground(paletteTrack)
+	chartAxisStyle = lipgloss.NewStyle().Foreground(paletteMuted)
+
+	staleBadgeStyle = lipgloss.NewStyle().
+		Foreground(paletteOnAccent).
+		Background(paletteMuted).
+		Padding(0, 1)
+	pausedBadgeStyle = lipgloss.NewStyle().
+		Foreground(paletteOnAccent).
+		Background(paletteAccent).
+		Padding(0, 1)
+	errorBannerStyle = lipgloss.NewStyle().
+		Foreground(paletteError)
+	detailKeyStyle = lipgloss.NewStyle().
+		Foreground(paletteMuted)
+	detailValueStyle = lipgloss.NewStyle().
+		Foreground(paletteText)
 }
@@ -0,0 +1,92 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
+	"claude-monitor/internal/utils"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TmuxOptions controls the tmux status snippet.
+type TmuxOptions struct {
+	// MaxAge marks the snapshot as stale once it is older than this.
+	MaxAge time.Duration
+	// Remaining appends the time left until each window resets.
+	Remaining bool
+	// Threshold is the utilization percentage rendered in the warning color;
+	// zero uses the default of 80.
+	Threshold float64
+	// Bands colors windows by severity instead (zero disables).
+	Bands Bands
+}
+
+// RenderTmux formats a snapshot as a tmux format string such as
+// "#[fg=#d77757]5h 42%#[default] #[fg=#d77757]7d 18%#[default]". With bands
+// each window takes its band color; otherwise windows at or above the
+// threshold use the error color. Stale data and windows whose reset has
+// already passed use the muted color.
+//
+// Parameters:
+//   - snap: cached sample, or nil when no snapshot exists yet.
+//   - now: reference time for staleness and remaining time.
+//   - opt: staleness, remaining-time, and threshold options.
+//
+// Returns:
+//   - single-line tmux format string.
+func RenderTmux(snap *store.Sample, now time.Time, opt TmuxOptions) string {
+	if snap == nil {
+		return tmuxSegment(paletteMuted, consts.TextBarNoData)
+	}
+	segments, stale := barSegments(*snap, now, barOptions{
+		maxAge:    opt.MaxAge,
+		remaining: opt.Remaining,
+		threshold: opt.Threshold,
+		bands:     opt.Bands,
+	})
+	if len(segments) == 0 {
+		return tmuxSegment(paletteMuted, consts.TextBarNoData)
+	}
+
+	parts := make([]string, 0, len(segments)+1)
+	for _, s := range segments {
+		parts = append(parts, tmuxSegment(s.color, s.text))
+	}
+	if stale {
+		parts = append(parts, tmuxSegment(paletteMuted, fmt.Sprintf(consts.TextStaleFmt, utils.FriendlyDuration(now.Sub(snap.Time)))))
+	}
+	return strings.Join(parts, " ")
+}
+
+// tmuxSegment wraps text in a tmux foreground style when color is set.
+func tmuxSegment(color lipgloss.TerminalColor, text string) string {
+	// tmux treats '#' as the start of a format sequence.
+	text = strings.ReplaceAll(text, "#", "##")
+	hex := colorHex(color)
+	if hex == "" {
+		return text
+	}
+	return fmt.Sprintf("#[fg=%s]%s#[default]", hex, text)
+}
+
+// colorHex returns the hex value of a palette color for outputs that cannot
+// adapt to the terminal background, preferring the dark variant.
+//
+// Parameters:
+//   - c: palette color.
+//
+// Returns:
+//   - color string (e.g. "#d77757"), or "" when colors are disabled.
+func colorHex(c lipgloss.TerminalColor) string {
+	switch c := c.(type) {
+	case lipgloss.AdaptiveColor:
+		return c.Dark
+	case lipgloss.Color:
+		return string(c)
+	}
+	return ""
+}
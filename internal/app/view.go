@@ -11,19 +11,21 @@ import (
 	"claude-monitor/internal/utils"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 )
 
 const (
 	minContainerWidth = 20
 	horizontalPadding = 2
+	// Bar cells are colored blanks; without colors they are drawn with
+	// these glyphs instead so the bar stays visible.
+	barGlyphFill  = "█"
+	barGlyphEmpty = "░"
 )
 
 var (
 	headerOnce     sync.Once
 	headerStatic   string
-	helpOnce       sync.Once
-	helpStatic     string
-	helpTextWidth  int
 	valueTextOnce  sync.Once
 	valueTextWidth int
 )
@@ -51,6 +53,19 @@ type barRenderOptions struct {
 	barEmptyStyle  lipgloss.Style
 	valueFormatter func(float64) string
 	metaBuilder    func(r chartRow, metrics barMetrics, opt barRenderOptions) string
+	// bands recolors the fill and value of each row by severity; the zero
+	// value keeps barFillStyle and valueStyle as given.
+	bands Bands
+	// detail returns an expanded panel drawn under a row; nil or an empty
+	// result draws none.
+	detail func(r chartRow) string
+}
+
+// resetRenderCaches drops the pre-rendered header and width caches so
+// they are rebuilt with the current styles.
+func resetRenderCaches() {
+	headerOnce = sync.Once{}
+	valueTextOnce = sync.Once{}
 }
 
 func headerCached() string {
@@ -60,14 +75,6 @@ func headerCached() string {
 	return headerStatic
 }
 
-func helpCached() (string, int) {
-	helpOnce.Do(func() {
-		helpStatic = renderHelp()
-		helpTextWidth = lipgloss.Width(helpStatic)
-	})
-	return helpStatic, helpTextWidth
-}
-
 func valueWidthCached() int {
 	valueTextOnce.Do(func() {
 		valueTextWidth = lipgloss.Width(valueBaseStyle.Render(consts.SpinnerSamplePercent))
@@ -99,20 +106,88 @@ func newLayout(windowWidth int) layout {
 //
 //	string - ANSI-styled layout containing header, body, and footer.
 func (m model) View() string {
-	frame := newLayout(m.width)
+	_, out := m.render()
+	return out
+}
 
-	header := headerCached()
-	body := renderBody(frame, m)
-	helpText, helpWidth := helpCached()
-	footer := renderFooter(frame.contentWidth, helpText, helpWidth, renderStatus(m), m.cfg.RefreshEvery)
+// render draws the current screen and reports which layout drew it; the
+// help overlay and the history and event screens count as full.
+func (m model) render() (LayoutMode, string) {
+	if m.showHelp {
+		return LayoutFull, renderHelpOverlay(m)
+	}
+	if m.view != viewDashboard {
+		return LayoutFull, renderFull(m)
+	}
+	switch m.cfg.Layout {
+	case LayoutCompact:
+		return LayoutCompact, renderCompact(m)
+	case LayoutMinimal:
+		return LayoutMinimal, renderMinimal(m)
+	case LayoutFull:
+		return LayoutFull, renderFull(m)
+	}
+	// Auto: the richest layout that fits the window height.
+	full := renderFull(m)
+	if m.height <= 0 || lipgloss.Height(full) <= m.height {
+		return LayoutFull, full
+	}
+	if compact := renderCompact(m); lipgloss.Height(compact) <= m.height {
+		return LayoutCompact, compact
+	}
+	return LayoutMinimal, renderMinimal(m)
+}
 
-	content := lipgloss.JoinVertical(lipgloss.Left, header, body, footer)
+// renderFull draws the page with the logo header, the bordered chart or
+// history box, and the help footer.
+func renderFull(m model) string {
+	frame := newLayout(m.width)
+	header := headerCached()
+	var body string
+	switch m.view {
+	case viewHistory:
+		body = renderHistory(frame, m)
+	case viewEvents:
+		body = renderEvents(frame, m)
+	default:
+		body = renderBody(frame, m)
+	}
+	content := lipgloss.JoinVertical(lipgloss.Left, header, body, renderFullFooter(frame, m))
 
 	return pageStyle.
 		Width(frame.containerWidth).
 		Render(content)
 }
 
+// renderFullFooter draws the short help and status line of the full page.
+func renderFullFooter(frame layout, m model) string {
+	helpText := helpStyle.Render(newHelp(frame.contentWidth).ShortHelpView(viewKeys{m.keys, m.view}.ShortHelp()))
+	return renderFooter(frame.contentWidth, helpText, lipgloss.Width(helpText), m.footerInfo())
+}
+
+// renderHelpOverlay draws the full keybinding reference for the current view
+// centered on an otherwise empty screen.
+//
+// Parameters:
+//
+//	m - current model with the key map, view, and window size.
+//
+// Returns:
+//
+//	string - rendered overlay filling the window.
+func renderHelpOverlay(m model) string {
+	frame := newLayout(m.width)
+	const boxFrame = 6
+	full := newHelp(utils.Max(frame.containerWidth-boxFrame, 0)).FullHelpView(viewKeys{m.keys, m.view}.FullHelp())
+	box := chartBoxStyle.Render(lipgloss.JoinVertical(lipgloss.Left,
+		labelBaseStyle.Render(consts.HelpOverlayTitle),
+		"",
+		full,
+		"",
+		statusStyle.Render(consts.HelpOverlayHint)))
+	return lipgloss.Place(frame.containerWidth, utils.Max(m.height, lipgloss.Height(box)), lipgloss.Center, lipgloss.Center, box)
+}
+
 // renderTitle renders the header title text with its styling.
 func renderTitle() string {
 	return headerStyle.
@@ -132,39 +207,111 @@ func renderHeader() string {
 	return lipgloss.JoinHorizontal(lipgloss.Top, mark, renderTitle())
 }
 
-// renderBody produces the chart area and any error state.
+// renderBody produces the chart area and any error state. When a fetch fails
+// but earlier data exists, the data stays on screen dimmed with a stale badge
+// and a compact error banner instead of being replaced by the error box.
 //
 // Parameters:
 //
 //	frame - layout sizing constraints.
-//	m     - current model containing rows and potential error.
+//	m     - current model containing usage and potential error.
 //
 // Returns:
 //
 //	string - rendered body content.
 func renderBody(frame layout, m model) string {
-	if m.err != nil {
-		return errorBox(formatError(m.err), frame.contentWidth)
+	rows := m.rows()
+
+	if len(rows) == 0 {
+		switch {
+		case m.err != nil:
+			return errorBox(formatError(m.err), frame.contentWidth)
+		case m.loading || m.lastUpdated.IsZero():
+			return renderSkeleton(frame.contentWidth)
+		default:
+			return consts.TextNoData
+		}
 	}
 
-	sections := make([]string, 0, 2)
+	chartWidth, innerWidth := chartWidths(frame)
+	bars := lipgloss.JoinVertical(lipgloss.Left, m.barBlocks(rows, innerWidth)...)
 
-	switch {
-	case len(m.rows) == 0:
-		if m.loading || m.lastUpdated.IsZero() {
-			sections = append(sections, renderSkeleton(frame.contentWidth))
-		} else {
-			sections = append(sections, consts.TextNoData)
+	if !m.isStale() {
+		box := chartBoxStyle
+		if m.cfg.Bands.enabled() {
+			box = box.BorderForeground(severityColor(m.cfg.Bands.level(peakPercent(rows))))
 		}
-	default:
-		const chartFrame = 6
-		chartWidth := utils.Max(12, frame.contentWidth-2)
-		innerWidth := utils.Max(4, chartWidth-chartFrame)
-		sections = append(sections,
-			chartBoxStyle.Width(chartWidth).Render(renderBars(m.rows, innerWidth)))
+		return box.Width(chartWidth).Render(bars)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left,
+		renderStaleBanner(m.err, m.now().Sub(m.lastUpdated), chartWidth),
+		chartBoxStyle.Width(chartWidth).Render(bars))
+}
+
+// chartWidths returns the outer width of the chart box and the width left
+// for its content.
+func chartWidths(frame layout) (chartWidth, innerWidth int) {
+	const chartFrame = 6
+	chartWidth = utils.Max(12, frame.contentWidth-2)
+	return chartWidth, utils.Max(4, chartWidth-chartFrame)
+}
+
+// barBlocks renders one block per row, dimmed when the data is stale and
+// with the detail panel under the expanded row.
+func (m model) barBlocks(rows []chartRow, innerWidth int) []string {
+	opt := defaultBarOptions(m.cfg.Bands)
+	if m.isStale() {
+		opt = staleBarOptions()
+	}
+	opt.detail = m.rowDetail
+	return renderBarBlocks(rows, innerWidth, opt)
+}
+
+// rows builds the chart rows for the current usage, or nil without data.
+func (m model) rows() []chartRow {
+	if m.usage == nil {
+		return nil
+	}
+	return buildRows(*m.usage, m.now())
+}
+
+// peakPercent returns the highest utilization among rows.
+func peakPercent(rows []chartRow) float64 {
+	peak := 0.0
+	for _, r := range rows {
+		peak = math.Max(peak, r.percent)
 	}
+	return peak
+}
+
+// isStale reports whether the displayed data did not come from the most
+// recent fetch: it was loaded from the snapshot or the last fetch failed.
+func (m model) isStale() bool {
+	return m.usage != nil && (m.fromCache || m.err != nil)
+}
 
-	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+// renderStaleBanner renders a single line with the (optional) error on the
+// left and the stale age badge on the right.
+//
+// Parameters:
+//
+//	err   - last fetch error, or nil when only the data age matters.
+//	age   - time since the displayed data was fetched.
+//	width - available line width.
+//
+// Returns:
+//
+//	string - rendered banner line.
+func renderStaleBanner(err error, age time.Duration, width int) string {
+	badge := staleBadgeStyle.Render(fmt.Sprintf(consts.TextStaleFmt, utils.FriendlyDuration(age)))
+	badgeW := lipgloss.Width(badge)
+	left := ""
+	if err != nil {
+		msg := fmt.Sprintf(consts.TextErrorBannerFmt, formatError(err))
+		left = errorBannerStyle.Render(truncateWidth(msg, utils.Max(width-badgeW-1, 0)))
+	}
+	gap := utils.Max(width-lipgloss.Width(left)-badgeW, 1)
+	return left + strings.Repeat(" ", gap) + badge
 }
 
 // errorBox renders a bordered error container sized to the provided width.
@@ -204,57 +351,101 @@ func renderStatus(m model) string {
 	case m.loading:
 		return fmt.Sprintf(consts.TextStatusFetch, m.sp.View())
 	case !m.lastUpdated.IsZero():
-		return utils.HumanTime(m.lastUpdated)
+		status := utils.HumanTimeAt(m.lastUpdated, m.now())
+		if m.beta != "" && m.beta != m.cfg.BetaHeader {
+			status += consts.TextSeparatorDot + fmt.Sprintf(consts.TextBetaAutoFmt, m.beta)
+		}
+		if m.notice != "" {
+			status += consts.TextSeparatorDot + m.notice
+		}
+		return status
 	default:
 		return consts.TextStatusWaiting
 	}
 }
 
-// renderHelp builds the keybinding legend.
-//
-// Returns:
-//
-//	string - inline help showing the available shortcuts.
-func renderHelp() string {
-	shortcuts := []struct {
-		keys []string
-		desc string
-	}{
-		{keys: []string{consts.HelpRefreshKey}, desc: consts.HelpRefreshDesc},
-		{keys: []string{consts.HelpQuitKey, consts.HelpQuitCtrlKey}, desc: consts.HelpQuitDesc},
+// footerInfo is the right-hand side of the footer.
+type footerInfo struct {
+	// status is the fetching or last-updated text.
+	status string
+	// nextPoll is when the scheduler will fetch next (zero hides it).
+	nextPoll time.Time
+	// interval is the base poll interval (zero hides it).
+	interval time.Duration
+	// paused shows the paused badge.
+	paused bool
+	// version is the build version, appended when it fits (empty hides it).
+	version string
+}
+
+// footerInfo collects the model's status, schedule, and version for the
+// footer.
+func (m model) footerInfo() footerInfo {
+	return footerInfo{
+		status:   renderStatus(m),
+		nextPoll: m.nextPoll,
+		interval: m.sched.base,
+		paused:   m.paused,
+		version:  m.version,
 	}
+}
 
-	parts := make([]string, 0, len(shortcuts))
-	for _, sc := range shortcuts {
-		key := helpKeyStyle.Render(strings.Join(sc.keys, consts.HelpKeyJoiner))
-		desc := helpDescStyle.Render(sc.desc)
-		parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top, key, consts.HelpSpacer, desc))
+// statusParts renders the paused badge, status, next poll, and interval as
+// pieces to join horizontally.
+func statusParts(info footerInfo) []string {
+	var parts []string
+	if info.paused {
+		parts = append(parts, pausedBadgeStyle.Render(consts.TextPausedBadge), " ")
 	}
-	return helpStyle.Render(strings.Join(parts, consts.TextSeparatorDot))
+	parts = append(parts, statusStyle.Render(info.status))
+	if !info.nextPoll.IsZero() {
+		nextText := fmt.Sprintf(consts.TextNextPollFmt, info.nextPoll.In(time.Local).Format(consts.NextPollLayout))
+		parts = append(parts,
+			separatorStyle.Render(consts.TextSeparatorDot),
+			statusStyle.Render(nextText))
+	}
+	if info.interval > 0 {
+		parts = append(parts,
+			separatorStyle.Render(consts.TextSeparatorDot),
+			statusStyle.Render(fmt.Sprintf(consts.TextIntervalFmt, shortDuration(info.interval))))
+	}
+	return parts
 }
 
-// renderFooter composes help text and right-aligned status/interval line.
+// renderFooter composes help text and right-aligned status/next-poll line.
 //
 // Parameters:
 //
 //	width      - total available footer width.
 //	helpText   - pre-rendered help legend.
 //	helpWidth  - width of the help legend.
-//	status     - status string (fetching or last updated).
-//	interval   - refresh interval to display.
+//	info       - status, schedule, and version shown on the right.
 //
 // Returns:
 //
 //	string - rendered footer line.
-func renderFooter(width int, helpText string, helpWidth int, status string, interval time.Duration) string {
-	intervalText := fmt.Sprintf(consts.TextIntervalFmt, interval)
-	rightContent := lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		statusStyle.Render(status),
-		separatorStyle.Render(consts.TextSeparatorDot),
-		statusStyle.Render(intervalText),
-	)
+func renderFooter(width int, helpText string, helpWidth int, info footerInfo) string {
+	parts := statusParts(info)
+	// The version hint is the first thing dropped when space runs out.
+	if info.version != "" {
+		hint := []string{
+			separatorStyle.Render(consts.TextSeparatorDot),
+			statusStyle.Render(info.version),
+		}
+		if helpWidth+lipgloss.Width(lipgloss.JoinHorizontal(lipgloss.Top, append(parts, hint...)...)) <= width {
+			parts = append(parts, hint...)
+		}
+	}
+	rightContent := lipgloss.JoinHorizontal(lipgloss.Top, parts...)
 	rightW := lipgloss.Width(rightContent)
+	if helpWidth+rightW > width && rightW <= width {
+		// Too narrow for one line: put the status under the help.
+		return footerStyle.
+			Width(width).
+			Render(lipgloss.JoinVertical(lipgloss.Left,
+				helpText,
+				lipgloss.PlaceHorizontal(width, lipgloss.Right, rightContent)))
+	}
 	lineWidth := utils.Max(width, helpWidth+rightW)
 	right := lipgloss.PlaceHorizontal(lineWidth-helpWidth, lipgloss.Right, rightContent)
 
@@ -269,12 +460,18 @@ func renderFooter(width int, helpText string, helpWidth int, status string, inte
 //
 //	rows       - chart data to render.
 //	totalWidth - width available for labels, bar, and value.
+//	bands      - severity bands coloring fills and values (zero disables).
 //
 // Returns:
 //
 //	string - vertical composition of rendered bars.
-func renderBars(rows []chartRow, totalWidth int) string {
-	return renderBarsWithOptions(rows, totalWidth, barRenderOptions{
+func renderBars(rows []chartRow, totalWidth int, bands Bands) string {
+	return renderBarsWithOptions(rows, totalWidth, defaultBarOptions(bands))
+}
+
+// defaultBarOptions returns the styles of live bars.
+func defaultBarOptions(bands Bands) barRenderOptions {
+	return barRenderOptions{
 		labelStyle:    labelBaseStyle,
 		valueStyle:    valueBaseStyle,
 		resetStyle:    &resetBaseStyle,
@@ -285,7 +482,8 @@ func renderBars(rows []chartRow, totalWidth int) string {
 			return fmt.Sprintf(consts.PercentFmt, p)
 		},
 		metaBuilder: defaultMetaBuilder,
-	})
+		bands:       bands,
+	}
 }
 
 // renderBarsWithOptions renders bars with custom styles, value formatting,
@@ -302,6 +500,20 @@ func renderBarsWithOptions(rows []chartRow, totalWidth int, opt barRenderOptions
 	if len(rows) == 0 {
 		return ""
 	}
+	return lipgloss.JoinVertical(lipgloss.Left, renderBarBlocks(rows, totalWidth, opt)...)
+}
+
+// renderBarBlocks renders each row as its own block: the bar line, the
+// metadata line, and any detail panel, spaced from the next block.
+//
+// Parameters:
+//   - rows: data to render.
+//   - totalWidth: available width for labels, bars, and values.
+//   - opt: styling and formatting hooks.
+//
+// Returns:
+//   - one ANSI-rendered block per row.
+func renderBarBlocks(rows []chartRow, totalWidth int, opt barRenderOptions) []string {
 
 	if opt.valueFormatter == nil {
 		opt.valueFormatter = func(p float64) string {
@@ -325,8 +537,18 @@ func renderBarsWithOptions(rows []chartRow, totalWidth int, opt barRenderOptions
 
 	for i, r := range rows {
 		percent := utils.Clamp(r.percent, 0, 100)
-		bar := renderProgressBarStyled(metrics.barWidth, percent/100, opt.barFillStyle, opt.barEmptyStyle)
-		value := valueStyle.Render(opt.valueFormatter(percent))
+		fillStyle, rowValueStyle := opt.barFillStyle, valueStyle
+		var cellColor func(float64) lipgloss.TerminalColor
+		if opt.bands.enabled() {
+			color := severityColor(opt.bands.level(percent))
+			fillStyle = fillStyle.Background(color)
+			rowValueStyle = rowValueStyle.Foreground(color)
+			if opt.bands.Gradient {
+				cellColor = opt.bands.gradientColor
+			}
+		}
+		bar := renderProgressBarStyled(metrics.barWidth, percent/100, fillStyle, opt.barEmptyStyle, cellColor)
+		value := rowValueStyle.Render(opt.valueFormatter(percent))
 
 		left := lipgloss.JoinHorizontal(lipgloss.Top,
 			labelStyle.Render(r.label),
@@ -339,9 +561,17 @@ func renderBarsWithOptions(rows []chartRow, totalWidth int, opt barRenderOptions
 
 		rendered := line
 		if meta != "" {
-			metaLine := metaStyle.Render(meta)
+			// Wrap within the row so continuation lines stay under the
+			// label indent.
+			metaLine := metaStyle.Width(utils.Max(totalWidth-metrics.labelWidth-1, 1)).Render(meta)
 			rendered = lipgloss.JoinVertical(lipgloss.Left, line, metaLine)
 		}
+		if opt.detail != nil {
+			if detail := opt.detail(r); detail != "" {
+				rendered = lipgloss.JoinVertical(lipgloss.Left, rendered,
+					metaStyle.MarginTop(1).MaxWidth(totalWidth).Render(detail))
+			}
+		}
 
 		if i == len(rows)-1 {
 			blocks = append(blocks, barLastBlockStyle.Render(rendered))
@@ -350,7 +580,19 @@ func renderBarsWithOptions(rows []chartRow, totalWidth int, opt barRenderOptions
 		}
 	}
 
-	return lipgloss.JoinVertical(lipgloss.Left, blocks...)
+	return blocks
+}
+
+// staleBarOptions returns the muted styles that signal outdated data.
+func staleBarOptions() barRenderOptions {
+	return barRenderOptions{
+		labelStyle:    skeletonLabelStyle,
+		valueStyle:    valueBaseStyle.Foreground(paletteMuted),
+		resetStyle:    &resetBaseStyle,
+		remainStyle:   ptrStyle(remainBaseStyle.Foreground(paletteMuted)),
+		barFillStyle:  skeletonBarFillStyle,
+		barEmptyStyle: barEmptyStyle,
+	}
 }
 
 // defaultMetaBuilder joins reset/remaining strings for a chart row.
@@ -492,7 +734,7 @@ func longestLabel(rows []chartRow, floor int) int {
 //
 //	string - rendered bar with filled and empty segments.
 func renderProgressBar(width int, pct float64) string {
-	return renderProgressBarStyled(width, pct, barFillStyle, barEmptyStyle)
+	return renderProgressBarStyled(width, pct, barFillStyle, barEmptyStyle, nil)
 }
 
 // renderProgressBarStyled draws a filled/empty bar with custom styles.
@@ -502,10 +744,12 @@ func renderProgressBar(width int, pct float64) string {
 //   - pct: progress fraction [0,1].
 //   - fillStyle: style for the filled portion.
 //   - emptyStyle: style for the empty portion.
+//   - cellColor: optional per-cell fill color by cell position (0–100); nil
+//     fills every cell with fillStyle as is.
 //
 // Returns:
 //   - rendered bar string.
-func renderProgressBarStyled(width int, pct float64, fillStyle, emptyStyle lipgloss.Style) string {
+func renderProgressBarStyled(width int, pct float64, fillStyle, emptyStyle lipgloss.Style, cellColor func(float64) lipgloss.TerminalColor) string {
 	if width <= 0 {
 		return ""
 	}
@@ -516,8 +760,20 @@ func renderProgressBarStyled(width int, pct float64, fillStyle, emptyStyle lipgl
 		fill = width
 	}
 
-	filled := fillStyle.Width(fill).Render(strings.Repeat(" ", fill))
-	empty := emptyStyle.Width(width - fill).Render(strings.Repeat(" ", width-fill))
+	fillCell, emptyCell := " ", " "
+	if lipgloss.ColorProfile() == termenv.Ascii {
+		fillCell, emptyCell = barGlyphFill, barGlyphEmpty
+	}
+	filled := fillStyle.Width(fill).Render(strings.Repeat(fillCell, fill))
+	if cellColor != nil && fillCell == " " {
+		var b strings.Builder
+		for i := 0; i < fill; i++ {
+			pos := (float64(i) + 0.5) / float64(width) * 100
+			b.WriteString(fillStyle.Background(cellColor(pos)).Render(fillCell))
+		}
+		filled = b.String()
+	}
+	empty := emptyStyle.Width(width - fill).Render(strings.Repeat(emptyCell, width-fill))
 
 	return filled + empty
 }
@@ -0,0 +1,174 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"claude-monitor/internal/api"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/report"
+	"claude-monitor/internal/utils"
+)
+
+// ErrQuotaBusy is returned by WaitForQuota with NoWait set when the window is
+// at or above the limit.
+var ErrQuotaBusy = errors.New(consts.ErrQuotaBusy)
+
+// WaitOptions controls WaitForQuota.
+type WaitOptions struct {
+	// Below is the utilization percent the window must drop under.
+	Below float64
+	// Window is the usage window key to gate on.
+	Window string
+	// NoWait returns ErrQuotaBusy instead of sleeping.
+	NoWait bool
+	// Log receives one progress line per check; nil discards them.
+	Log io.Writer
+}
+
+// Validate checks the threshold and window.
+//
+// Returns:
+//   - nil when options are usable.
+//   - error naming the invalid value.
+func (o WaitOptions) Validate() error {
+	if o.Below <= 0 || o.Below > 100 {
+		return errors.New(consts.ErrThresholdRange)
+	}
+	if !knownWindow(o.Window) {
+		return fmt.Errorf(consts.ErrWindowFmt, o.Window)
+	}
+	return nil
+}
+
+// WaitForQuota blocks until the selected window's utilization is below the
+// threshold or its last known reset time has passed, whether the check after
+// the reset succeeds (and still reports the old window) or fails. While
+// blocked it checks at most every max interval and always right after the
+// reset; failed fetches back off like the TUI.
+//
+// Parameters:
+//   - ctx: cancels the wait.
+//   - cfg: validated Config.
+//   - opt: threshold, window, and behavior options.
+//
+// Returns:
+//   - nil once quota is available.
+//   - ErrQuotaBusy (wrapped with details) when NoWait is set and the window
+//     is over the threshold.
+//   - the fetch error when NoWait is set and the fetch fails.
+//   - ctx.Err() when canceled.
+func WaitForQuota(ctx context.Context, cfg Config, opt WaitOptions) error {
+	if err := opt.Validate(); err != nil {
+		return err
+	}
+	log := opt.Log
+	if log == nil {
+		log = io.Discard
+	}
+	win := windowByKey(opt.Window)
+	sched := newScheduler(cfg)
+	var resetAt time.Time
+	failures := 0
+
+	for {
+		reqCtx, cancel := requestContext(ctx, cfg)
+		data, _, err := fetchAndPersist(reqCtx, cfg)
+		cancel()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		now := time.Now()
+		var delay time.Duration
+		switch {
+		case err != nil:
+			if opt.NoWait {
+				return err
+			}
+			if !resetAt.IsZero() && !now.Before(resetAt) {
+				fmt.Fprintf(log, consts.TextWaitResetPassedFmt+"\n", formatError(err))
+				return nil
+			}
+			failures++
+			delay = sched.afterError(failures, retryAfterOf(err))
+			fmt.Fprintf(log, consts.TextWaitErrorFmt+"\n", formatError(err), utils.FriendlyDuration(delay))
+		default:
+			failures = 0
+			u := win.Pick(data)
+			if u == nil || u.Utilization == nil {
+				fmt.Fprintln(log, consts.TextWaitNoData)
+				return nil
+			}
+			if *u.Utilization < opt.Below {
+				return nil
+			}
+			resetAt = time.Time{}
+			if u.ResetsAt != nil {
+				resetAt = *u.ResetsAt
+			}
+			if !resetAt.IsZero() && !now.Before(resetAt) {
+				fmt.Fprintln(log, consts.TextWaitResetPassed)
+				return nil
+			}
+			status := waitStatus(barLabel(win.Key), *u, opt.Below)
+			if opt.NoWait {
+				return fmt.Errorf("%w: %s", ErrQuotaBusy, status)
+			}
+			delay = waitDelay(sched, now, resetAt)
+			fmt.Fprintf(log, consts.TextWaitFmt+"\n", status, utils.FriendlyDuration(delay))
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// waitDelay sleeps as long as the scheduler allows while blocked, but wakes
+// just after the window resets.
+func waitDelay(s scheduler, now, resetAt time.Time) time.Duration {
+	d := s.maxEvery
+	if !resetAt.IsZero() {
+		if until := resetAt.Sub(now) + resetGrace; until < d {
+			d = until
+		}
+	}
+	if d < s.minEvery {
+		d = s.minEvery
+	}
+	return d
+}
+
+// waitStatus describes a blocked window: label, utilization, limit and reset.
+func waitStatus(label string, u api.WindowUsage, below float64) string {
+	status := fmt.Sprintf(consts.TextWaitStatusFmt, label, *u.Utilization, below)
+	if u.ResetsAt != nil {
+		switch reset, remain := utils.FormatReset(*u.ResetsAt); {
+		case reset == "":
+			status += consts.TextSeparatorDot + remain
+		case remain == "":
+			status += consts.TextSeparatorDot + reset
+		default:
+			status += fmt.Sprintf(" · %s (%s)", reset, remain)
+		}
+	}
+	return status
+}
+
+// windowByKey returns the report window for key, defaulting to five_hour.
+func windowByKey(key string) report.Window {
+	for _, w := range report.Windows {
+		if w.Key == key {
+			return w
+		}
+	}
+	return report.Windows[0]
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"claude-monitor/internal/consts"
 )
@@ -16,9 +17,70 @@ import (
 type credentialsFile struct {
 	ClaudeOauth struct {
 		AccessToken string `json:"accessToken"`
+		ExpiresAt   int64  `json:"expiresAt"`
 	} `json:"claudeAiOauth"`
 }
 
+// CredentialsInfo describes the credentials file for diagnostics without
+// failing on the first problem the way ResolveToken does.
+type CredentialsInfo struct {
+	// Path is the expanded file path.
+	Path string
+	// Exists reports whether the file could be stat'ed.
+	Exists bool
+	// Mode holds the file permissions when Exists is true.
+	Mode fs.FileMode
+	// ReadErr is set when the file is missing or unreadable.
+	ReadErr error
+	// ParseErr is set when the file is not valid credentials JSON.
+	ParseErr error
+	// HasToken reports whether claudeAiOauth.accessToken is non-empty.
+	HasToken bool
+	// ExpiresAt is the token expiry, or zero when the file does not record one.
+	ExpiresAt time.Time
+}
+
+// TokenFromEnv reports whether the OAuth token is supplied by the environment,
+// which takes precedence over the credentials file.
+func TokenFromEnv() bool {
+	return strings.TrimSpace(os.Getenv(consts.EnvTokenName)) != ""
+}
+
+// InspectCredentials reads credPath and reports everything ResolveToken would
+// check: existence, permissions, JSON shape, token presence, and expiry.
+//
+// Parameters:
+//   - credPath: path to credentials JSON; a leading "~" is expanded.
+//
+// Returns:
+//   - CredentialsInfo with the first read or parse error recorded.
+func InspectCredentials(credPath string) CredentialsInfo {
+	info := CredentialsInfo{Path: expandHome(credPath)}
+	st, err := os.Stat(info.Path)
+	if err != nil {
+		info.ReadErr = err
+		return info
+	}
+	info.Exists = true
+	info.Mode = st.Mode().Perm()
+
+	content, err := os.ReadFile(info.Path)
+	if err != nil {
+		info.ReadErr = err
+		return info
+	}
+	var creds credentialsFile
+	if err := json.Unmarshal(content, &creds); err != nil {
+		info.ParseErr = err
+		return info
+	}
+	info.HasToken = strings.TrimSpace(creds.ClaudeOauth.AccessToken) != ""
+	if creds.ClaudeOauth.ExpiresAt > 0 {
+		info.ExpiresAt = time.UnixMilli(creds.ClaudeOauth.ExpiresAt)
+	}
+	return info
+}
+
 // ResolveToken finds the OAuth token from the environment or a credentials file.
 //
 // Parameters:
@@ -41,7 +103,7 @@ func ResolveToken(credPath string) (string, error) {
 	if info, err := os.Stat(path); err == nil {
 		if mode := info.Mode(); mode&fs.ModePerm != 0 {
 			if mode.Perm()&0o077 != 0 {
-				return "", fmt.Errorf("credentials file %s must not be group/other readable (mode %v); set chmod 600", path, mode.Perm())
+				return "", fmt.Errorf(consts.ErrCredentialsModeFmt, path, mode.Perm())
 			}
 		}
 	}
@@ -0,0 +1,91 @@
+package buildinfo
+
+import (
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+)
+
+// version can be stamped at link time with
+// -ldflags "-X claude-monitor/internal/buildinfo.version=release-42"; the
+// module version from the build info is used otherwise.
+var version = ""
+
+// Info describes the running binary.
+type Info struct {
+	// Version is the release or module version ("devel" for local builds).
+	Version string `json:"version"`
+	// Revision is the VCS commit the binary was built from.
+	Revision string `json:"revision,omitempty"`
+	// Dirty reports uncommitted changes in the build tree.
+	Dirty bool `json:"dirty"`
+	// Time is the VCS commit time recorded at build.
+	Time time.Time `json:"time"`
+	// GoVersion is the toolchain that built the binary.
+	GoVersion string `json:"go_version"`
+}
+
+var (
+	readOnce sync.Once
+	info     Info
+)
+
+// Read returns build metadata from runtime/debug.ReadBuildInfo, computed once.
+func Read() Info {
+	readOnce.Do(func() {
+		info = Info{Version: version}
+		bi, ok := debug.ReadBuildInfo()
+		if !ok {
+			if info.Version == "" {
+				info.Version = "devel"
+			}
+			return
+		}
+		info.GoVersion = bi.GoVersion
+		if info.Version == "" {
+			info.Version = strings.Trim(bi.Main.Version, "()")
+		}
+		if info.Version == "" {
+			info.Version = "devel"
+		}
+		for _, s := range bi.Settings {
+			switch s.Key {
+			case "vcs.revision":
+				info.Revision = s.Value
+			case "vcs.modified":
+				info.Dirty = s.Value == "true"
+			case "vcs.time":
+				info.Time, _ = time.Parse(time.RFC3339, s.Value)
+			}
+		}
+	})
+	return info
+}
+
+// ShortRevision returns the first 7 characters of the revision, with a "*"
+// suffix for dirty builds.
+func (i Info) ShortRevision() string {
+	rev := i.Revision
+	if len(rev) > 7 {
+		rev = rev[:7]
+	}
+	if rev != "" && i.Dirty {
+		rev += "*"
+	}
+	return rev
+}
+
+// Short is a compact "version (revision)" label for footers. Untagged
+// builds carry a long pseudo-version, so only the revision is shown for them.
+func (i Info) Short() string {
+	rev := i.ShortRevision()
+	v := strings.TrimSuffix(i.Version, "+dirty")
+	if rev != "" && (v == "devel" || strings.HasPrefix(v, "v0.0.0-")) {
+		return rev
+	}
+	if rev != "" {
+		return v + " (" + rev + ")"
+	}
+	return v
+}
@@ -0,0 +1,19 @@
+package consts
+
+import "fmt"
+
+// Plural holds the singular and plural form of a message about a count;
+// both take the count as their only verb. Locales without plural
+// inflection use the same text for both.
+type Plural struct {
+	One   string
+	Other string
+}
+
+// Format picks the form for n and fills in the count.
+func (p Plural) Format(n int) string {
+	if n == 1 {
+		return fmt.Sprintf(p.One, n)
+	}
+	return fmt.Sprintf(p.Other, n)
+}
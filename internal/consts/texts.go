@@ -1,34 +1,19 @@
 package consts
 
-// User-facing copy and keys used across the application.
+import "time"
+
+// Identifiers such as keys, flag names, and file names are constants. Copy
+// shown to people is kept in variables so i18n.Apply can swap in the active
+// locale's catalog at startup.
+
+// Keys and names used across the application.
 const (
-	// HeaderTitle is the banner title shown in the UI header.
-	HeaderTitle = "Claude Code Usage"
-	// TextNoData indicates no utilization was returned.
-	TextNoData = "No utilization data available."
 	// TextSeparatorDot is the middle dot separator for inline lists.
 	TextSeparatorDot = " · "
-	// TextIntervalFmt formats the refresh interval in the footer.
-	TextIntervalFmt = "interval %s"
-	// TextStatusFetch shows while a request is running.
-	TextStatusFetch = "%s fetching latest…"
-	// TextStatusWaiting shows before the first sample arrives.
-	TextStatusWaiting = "waiting for first sample…"
-	// TextErrorFmt prefixes fatal errors from main.
-	TextErrorFmt = "error: %v"
+	// NextPollLayout formats the next-poll clock time.
+	NextPollLayout = "15:04:05"
 	// TextHTTPErrorFmt formats HTTP status and body on failure.
 	TextHTTPErrorFmt = "http %d: %s"
-	// TextTokenErrorFmt formats token resolution errors.
-	TextTokenErrorFmt = "token error: %v"
-	// TextConfigErrFmt formats configuration validation errors.
-	TextConfigErrFmt = "config error: %v"
-	// TextAppErrFmt formats Bubble Tea runtime errors.
-	TextAppErrFmt = "app error: %v"
-
-	// LabelCurrent is the row label for 5-hour usage.
-	LabelCurrent = "Current"
-	// LabelWeekly is the row label for 7-day usage.
-	LabelWeekly = "Weekly"
 
 	// EnvBetaHeader names the env var for the Anthropic beta header.
 	EnvBetaHeader = "ANTHROPIC_BETA_HEADER"
@@ -48,14 +33,16 @@ const (
 	FlagTimeoutName = "http-timeout"
 	// FlagBetaName is the CLI flag name for beta header value.
 	FlagBetaName = "beta-header"
-	// FlagIntervalHelp describes the interval flag.
-	FlagIntervalHelp = "poll interval (e.g. 15s, 1m)"
-	// FlagCredsHelp describes the creds flag.
-	FlagCredsHelp = "path to credentials JSON (uses ANTHROPIC_OAUTH_TOKEN if set)"
-	// FlagTimeoutHelp describes the HTTP timeout flag.
-	FlagTimeoutHelp = "HTTP timeout (e.g. 5s, 2s)"
-	// FlagBetaHelp describes the beta header flag.
-	FlagBetaHelp = "Anthropic beta header value"
+	// FlagBetaStateName is the CLI flag name for the remembered beta header file.
+	FlagBetaStateName = "beta-state"
+	// FlagHistoryName is the CLI flag name for the sample log path.
+	FlagHistoryName = "history"
+	// FlagMinIntervalName is the CLI flag name for the fastest poll cadence.
+	FlagMinIntervalName = "min-interval"
+	// FlagMaxIntervalName is the CLI flag name for the slowest poll cadence.
+	FlagMaxIntervalName = "max-interval"
+	// FlagCacheName is the CLI flag name for the last-good snapshot path.
+	FlagCacheName = "cache"
 
 	// HelpRefreshKey is the lowercase key to refresh now.
 	HelpRefreshKey = "r"
@@ -65,14 +52,20 @@ const (
 	HelpQuitKey = "q"
 	// HelpQuitCtrlKey is the ctrl key combo to quit.
 	HelpQuitCtrlKey = "ctrl+c"
-	// HelpRefreshDesc describes the refresh shortcut.
-	HelpRefreshDesc = "refresh now"
-	// HelpQuitDesc describes the quit shortcut.
-	HelpQuitDesc = "quit"
+	// HelpHistoryKey toggles the history chart.
+	HelpHistoryKey = "h"
+	// HelpBackKey leaves the history chart.
+	HelpBackKey = "esc"
+	// HelpPauseKey pauses or resumes polling.
+	HelpPauseKey = "p"
+	// HelpFasterKey steps the poll interval down to the previous preset.
+	HelpFasterKey = "-"
+	// HelpSlowerKey steps the poll interval up to the next preset.
+	HelpSlowerKey = "+"
+	// HelpSlowerAltKey is the unshifted alternative to HelpSlowerKey.
+	HelpSlowerAltKey = "="
 	// HelpKeyJoiner joins multiple keys in help text.
 	HelpKeyJoiner = "/"
-	// HelpSpacer separates key and description.
-	HelpSpacer = " "
 
 	// SpinnerSamplePercent provides width for spinner/value alignment.
 	SpinnerSamplePercent = "100.0%"
@@ -85,8 +78,92 @@ const (
 	DefaultCredRelPath = ".claude/.credentials.json"
 	// TildePrefix marks a path that should expand to the home directory.
 	TildePrefix = "~"
-	// ResetTimeLayout formats reset timestamps for display.
-	ResetTimeLayout = "15:04 MST Jan 02"
+	// ErrQuotaBusy signals a window over the wait limit with -no-wait.
+	ErrQuotaBusy = "quota unavailable"
+	// TextErrorBannerFmt prefixes the compact error banner over stale data.
+	TextErrorBannerFmt = "⚠ %s"
+)
+
+// Built-in values that are not copy but cannot be constants.
+var (
+	// BuiltinBetaCandidates lists the beta values tried after the configured
+	// and remembered ones, so a stale -beta-header still reaches a working
+	// value. Add successors here as Anthropic announces them.
+	BuiltinBetaCandidates = []string{DefaultBetaName}
+)
+
+// User-facing copy used across the application.
+var (
+	// HeaderTitle is the banner title shown in the UI header.
+	HeaderTitle = "Claude Code Usage"
+	// TextNoData indicates no utilization was returned.
+	TextNoData = "No utilization data available."
+	// TextIntervalFmt formats the refresh interval in the footer.
+	TextIntervalFmt = "interval %s"
+	// TextNextPollFmt formats the scheduled time of the next fetch in the footer.
+	TextNextPollFmt = "next poll %s"
+	// TextStatusFetch shows while a request is running.
+	TextStatusFetch = "%s fetching latest…"
+	// TextStatusWaiting shows before the first sample arrives.
+	TextStatusWaiting = "waiting for first sample…"
+	// TextErrorFmt prefixes fatal errors from main.
+	TextErrorFmt = "error: %v"
+	// TextTokenErrorFmt formats token resolution errors.
+	TextTokenErrorFmt = "token error: %v"
+	// TextConfigErrFmt formats configuration validation errors.
+	TextConfigErrFmt = "config error: %v"
+	// TextAppErrFmt formats Bubble Tea runtime errors.
+	TextAppErrFmt = "app error: %v"
+	// TextBetaDefaultWarning warns that the baked-in beta header is in use.
+	TextBetaDefaultWarning = "warning: using baked-in beta header; override -beta-header or " + EnvBetaHeader + " when Anthropic rotates betas"
+	// TextTimeoutEnvWarningFmt warns about an unparsable timeout env var:
+	// variable, value, default used.
+	TextTimeoutEnvWarningFmt = "warning: invalid %s value %q; using default %s"
+
+	// LabelCurrent is the row label for 5-hour usage.
+	LabelCurrent = "Current"
+	// LabelWeekly is the row label for 7-day usage.
+	LabelWeekly = "Weekly"
+	// FlagIntervalHelp describes the interval flag.
+	FlagIntervalHelp = "poll interval (e.g. 15s, 1m)"
+	// FlagCredsHelp describes the creds flag.
+	FlagCredsHelp = "path to credentials JSON (uses ANTHROPIC_OAUTH_TOKEN if set)"
+	// FlagTimeoutHelp describes the HTTP timeout flag.
+	FlagTimeoutHelp = "HTTP timeout (e.g. 5s, 2s)"
+	// FlagBetaHelp describes the beta header flag.
+	FlagBetaHelp = "Anthropic beta header value; comma-separate fallbacks tried in order when the API rejects it"
+	// FlagBetaStateHelp describes the beta-state flag.
+	FlagBetaStateHelp = "file remembering the beta header that last worked (empty disables)"
+	// TextBetaAutoFmt notes in the status line that a fallback beta header is in use.
+	TextBetaAutoFmt = "beta %s (auto)"
+	// FlagHistoryHelp describes the history flag.
+	FlagHistoryHelp = "path to the local sample log (empty disables recording)"
+	// FlagMinIntervalHelp describes the min-interval flag.
+	FlagMinIntervalHelp = "fastest adaptive poll interval (default interval/4)"
+	// FlagMaxIntervalHelp describes the max-interval flag.
+	FlagMaxIntervalHelp = "slowest adaptive poll interval, also caps error backoff (default interval×8)"
+	// FlagCacheHelp describes the cache flag.
+	FlagCacheHelp = "path to the last-good snapshot shown at launch and while offline (empty disables)"
+	// HelpRefreshDesc describes the refresh shortcut.
+	HelpRefreshDesc = "refresh now"
+	// HelpQuitDesc describes the quit shortcut.
+	HelpQuitDesc = "quit"
+	// HelpHistoryDesc describes the history shortcut.
+	HelpHistoryDesc = "history"
+	// HelpPauseDesc describes the pause shortcut.
+	HelpPauseDesc = "pause"
+	// HelpIntervalDesc describes the interval keys.
+	HelpIntervalDesc = "interval"
+	// TextPausedBadge marks the footer while polling is paused.
+	TextPausedBadge = "paused"
+	// HelpBackDesc describes leaving the history chart.
+	HelpBackDesc = "back"
+	// HelpRangeDesc describes the range selection keys.
+	HelpRangeDesc = "range"
+	// HelpPanDesc describes the pan keys.
+	HelpPanDesc = "pan"
+	// HelpZoomDesc describes the zoom keys.
+	HelpZoomDesc = "zoom"
 
 	// ErrTokenRequired signals missing token in config.
 	ErrTokenRequired = "token required"
@@ -96,21 +173,56 @@ const (
 	ErrHTTPClientTimeout = "http client timeout must be positive"
 	// ErrRefreshInterval signals invalid refresh cadence.
 	ErrRefreshInterval = "refresh interval must be positive"
+	// ErrCacheRequired signals a background refresher without a snapshot path.
+	ErrCacheRequired = "-cache must be set to keep the snapshot warm"
+	// ErrRefreshBounds signals inconsistent min/max refresh bounds.
+	ErrRefreshBounds = "min/max interval must be positive and min must not exceed max"
+	// ErrRefreshTooSmallFmt formats a refresh interval below the minimum.
+	ErrRefreshTooSmallFmt = "refresh interval too small; must be at least %s"
+	// ErrMinRefreshTooSmallFmt formats a min refresh interval below the minimum.
+	ErrMinRefreshTooSmallFmt = "min refresh interval too small; must be at least %s"
+	// ErrThresholdRange signals a threshold outside 0–100.
+	ErrThresholdRange = "threshold must be between 0 and 100"
 	// ErrMissingToken signals missing OAuth token before request.
 	ErrMissingToken = "missing OAuth token"
 	// ErrBetaHeaderRequired signals missing beta header value.
 	ErrBetaHeaderRequired = "beta header required"
 	// ErrReadCredentialsFmt formats credential read failures.
 	ErrReadCredentialsFmt = "read credentials: %w"
+	// ErrCredentialsModeFmt rejects a credentials file others can read:
+	// path, mode.
+	ErrCredentialsModeFmt = "credentials file %s must not be group/other readable (mode %v); set chmod 600"
 	// ErrParseCredentialsFmt formats credential parse failures.
 	ErrParseCredentialsFmt = "parse credentials: %w"
 	// ErrEmptyAccessToken signals empty token inside the credentials file.
 	ErrEmptyAccessToken = "accessToken empty in credentials file"
+	// ErrParseSnapshotFmt formats snapshot parse failures.
+	ErrParseSnapshotFmt = "parse snapshot: %w"
+	// ErrReadSamplesFmt formats sample log read failures.
+	ErrReadSamplesFmt = "read samples: %w"
+	// ErrSpanInvalidFmt formats invalid look-back spans such as -since.
+	ErrSpanInvalidFmt = "invalid span %q (use e.g. 90m, 24h, 7d, 2w)"
+	// ErrGroupByFmt formats unknown report grouping values.
+	ErrGroupByFmt = "unknown group-by %q (use day, hour or window)"
+	// ErrFormatFmt formats unknown output format values.
+	ErrFormatFmt = "unknown format %q"
+	// ErrTimeFormatFmt formats unknown timestamp format values.
+	ErrTimeFormatFmt = "unknown time format %q (use rfc3339 or epoch)"
+	// ErrWindowFmt formats unknown usage window names.
+	ErrWindowFmt = "unknown window %q (use five_hour or seven_day)"
+	// ErrCheckThresholdFmt formats malformed -warn/-crit values.
+	ErrCheckThresholdFmt = "invalid threshold %q (use a percent 0–100, optionally window=percent)"
+	// ErrCheckThresholdOrderFmt signals a warning threshold above the critical one.
+	ErrCheckThresholdOrderFmt = "%s: warning threshold %g is above critical %g"
+	// ErrTimeValueFmt formats unparseable -from/-until values.
+	ErrTimeValueFmt = "invalid time %q (use RFC3339, e.g. 2025-01-02T15:04:05Z)"
 
 	// TextRequestTimedOut is shown when a request exceeds its deadline.
 	TextRequestTimedOut = "request timed out"
 	// TextRequestCanceled is shown when a request is canceled.
 	TextRequestCanceled = "request canceled"
+	// TextStaleFmt formats the badge shown on cached or outdated data.
+	TextStaleFmt = "stale %s"
 	// TextSkeletonReset is placeholder reset text in the loading skeleton.
 	TextSkeletonReset = "resets at …"
 	// TextSkeletonLeft is placeholder remaining text in the loading skeleton.
@@ -118,7 +230,7 @@ const (
 )
 
 // Time and duration formatting strings used in the UI.
-const (
+var (
 	// TextSecondsFmt formats seconds for “updated ago” messages.
 	TextSecondsFmt = "%ds"
 	// TextLtMinute indicates a duration under one minute.
@@ -143,4 +255,1113 @@ const (
 	TextUpdatedNow = "updated right now"
 	// TextUpdatedAgo formats time since last update.
 	TextUpdatedAgo = "updated %s ago"
+	// ShortMonthNames and ShortWeekdayNames replace the "Jan" and "Mon"
+	// tokens of time layouts, January and Sunday first.
+	ShortMonthNames   = [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
+	ShortWeekdayNames = [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+	// DayPeriodNames replaces the "PM" token of 12-hour layouts, morning
+	// first.
+	DayPeriodNames = [2]string{"AM", "PM"}
+)
+
+// Local state and sample history.
+const (
+	// AppDirName names the per-user state directory under the cache dir.
+	AppDirName = "claude-monitor"
+	// SamplesFileName is the JSON Lines file holding recorded samples.
+	SamplesFileName = "samples.jsonl"
+	// SnapshotFileName is the JSON file holding the last good sample.
+	SnapshotFileName = "snapshot.json"
+	// BetaFileName is the JSON file remembering the working beta header.
+	BetaFileName = "beta.json"
+	// LockFileSuffix names the lock file guarding the sample log, appended
+	// to its path.
+	LockFileSuffix = ".lock"
+	// SampleRetention is how long recorded samples are kept.
+	SampleRetention = 90 * 24 * time.Hour
+	// WindowFiveHour is the API key of the rolling 5-hour window.
+	WindowFiveHour = "five_hour"
+	// WindowSevenDay is the API key of the rolling 7-day window.
+	WindowSevenDay = "seven_day"
+)
+
+// Report subcommand flags and copy.
+const (
+	// CmdReport is the subcommand that renders a usage report.
+	CmdReport = "report"
+
+	// FlagSinceName is the CLI flag name for the look-back span.
+	FlagSinceName = "since"
+	// FlagGroupByName is the CLI flag name for report grouping.
+	FlagGroupByName = "group-by"
+	// FlagFormatName is the CLI flag name for output format.
+	FlagFormatName = "format"
+	// FlagThresholdName is the CLI flag name for the high-usage threshold.
+	FlagThresholdName = "threshold"
+
+	// FormatText selects styled terminal output.
+	FormatText = "text"
+	// FormatMarkdown selects GitHub-flavored Markdown output.
+	FormatMarkdown = "markdown"
+)
+
+// Report copy.
+var (
+	// FlagSinceHelp describes the since flag.
+	FlagSinceHelp = "look-back span (e.g. 24h, 7d, 2w)"
+	// FlagGroupByHelp describes the group-by flag.
+	FlagGroupByHelp = "group periods by day, hour or window"
+	// FlagReportFormatHelp describes the report format flag.
+	FlagReportFormatHelp = "output format: text or markdown"
+	// FlagThresholdHelp describes the threshold flag.
+	FlagThresholdHelp = "utilization percent counted as high usage"
+
+	// ReportDayLayout formats day buckets.
+	ReportDayLayout = "Mon Jan 02"
+	// ReportHourLayout formats hour and window buckets.
+	ReportHourLayout = "Jan 02 15:04"
+	// ReportRangeLayout formats the covered range in the report title.
+	ReportRangeLayout = "Jan 02 15:04"
+
+	// TextReportTitle heads the report.
+	TextReportTitle = "Claude Code usage report"
+	// TextReportRangeFmt describes the covered range, grouping and threshold.
+	TextReportRangeFmt = "%s – %s · grouped by %s · threshold %.0f%%"
+	// TextReportWindowFmt labels a window bucket by its reset time.
+	TextReportWindowFmt = "until %s"
+	// TextReportUnknownWindow labels samples without a reset time.
+	TextReportUnknownWindow = "unknown window"
+	// TextReportEmpty is shown when no samples fall in the range.
+	TextReportEmpty = "No samples recorded in this range. Samples are recorded while the monitor runs."
+	// TextReportResetsTitle heads the reset events list.
+	TextReportResetsTitle = "Reset events"
+	// TextReportNoResets is shown when no resets were observed.
+	TextReportNoResets = "none observed"
+	// TextReportResetFmt formats a reset event: time, window, peak before.
+	TextReportResetFmt = "%s  %s reset (was %.1f%%)"
+	// TextReportNone marks an empty duration cell.
+	TextReportNone = "–"
+
+	// ColPeriod heads the period column.
+	ColPeriod = "Period"
+	// ColWindow heads the window column.
+	ColWindow = "Window"
+	// ColPeak heads the peak utilization column.
+	ColPeak = "Peak"
+	// ColAboveFmt heads the time-above-threshold column.
+	ColAboveFmt = "≥%.0f%%"
+	// ColLimitHits heads the limit hit count column.
+	ColLimitHits = "Limit hits"
+	// ColResets heads the reset count column.
+	ColResets = "Resets"
+	// ColSamples heads the sample count column.
+	ColSamples = "Samples"
+)
+
+// History chart keys and copy.
+const (
+	// HistoryRange1Key through HistoryRange4Key select the chart range.
+	HistoryRange1Key = "1"
+	HistoryRange2Key = "2"
+	HistoryRange3Key = "3"
+	HistoryRange4Key = "4"
+	// HistoryZoomInKey narrows the chart range.
+	HistoryZoomInKey = "+"
+	// HistoryZoomInAltKey narrows the chart range without shift.
+	HistoryZoomInAltKey = "="
+	// HistoryZoomOutKey widens the chart range.
+	HistoryZoomOutKey = "-"
+	// HistoryPanLeftKey pans the chart back in time.
+	HistoryPanLeftKey = "left"
+	// HistoryPanRightKey pans the chart toward now.
+	HistoryPanRightKey = "right"
+	// HistoryPanLeftSymbol and HistoryPanRightSymbol show the pan keys in help.
+	HistoryPanLeftSymbol  = "←"
+	HistoryPanRightSymbol = "→"
+	// HistoryLiveKey jumps back to the live edge.
+	HistoryLiveKey = "end"
+
+	// ChartTimeLayout formats x-axis labels for ranges up to a day.
+	ChartTimeLayout = "15:04"
+)
+
+// History chart copy.
+var (
+	// ChartDateLayout formats x-axis labels for longer ranges.
+	ChartDateLayout = "Jan 02"
+
+	// TextHistoryTitle heads the history screen.
+	TextHistoryTitle = "History"
+	// TextHistoryEndingFmt notes the end of a panned range.
+	TextHistoryEndingFmt = "ending %s"
+	// TextHistoryLoading is shown while samples are read from disk.
+	TextHistoryLoading = "loading history…"
+	// TextHistoryEmpty is shown when no samples have been recorded yet.
+	TextHistoryEmpty = "No samples recorded yet; history fills in as the monitor runs."
+	// TextHistoryDisabled is shown when sample recording is turned off.
+	TextHistoryDisabled = "Sample recording is disabled; set -history to a file path to enable the chart."
+	// TextChartResetLegend labels the reset boundary marker.
+	TextChartResetLegend = "reset"
+)
+
+// Export subcommand flags and formats.
+const (
+	// CmdExport is the subcommand that dumps recorded samples.
+	CmdExport = "export"
+
+	// FlagFromName is the CLI flag name for an absolute range start.
+	FlagFromName = "from"
+	// FlagUntilName is the CLI flag name for an absolute range end.
+	FlagUntilName = "until"
+	// FlagWindowName is the CLI flag name for the usage window filter.
+	FlagWindowName = "window"
+	// FlagTimeFormatName is the CLI flag name for timestamp encoding.
+	FlagTimeFormatName = "time-format"
+	// FlagOutputName is the CLI flag name for the output file.
+	FlagOutputName = "o"
+
+	// FormatCSV selects comma-separated output.
+	FormatCSV = "csv"
+	// FormatTSV selects tab-separated output.
+	FormatTSV = "tsv"
+	// FormatJSONL selects JSON Lines output.
+	FormatJSONL = "jsonl"
+	// TimeFormatRFC3339 encodes timestamps as RFC3339 UTC strings.
+	TimeFormatRFC3339 = "rfc3339"
+	// TimeFormatEpoch encodes timestamps as Unix seconds.
+	TimeFormatEpoch = "epoch"
+)
+
+// Export flag help.
+var (
+	// FlagFromHelp describes the from flag.
+	FlagFromHelp = "only samples at or after this RFC3339 time"
+	// FlagUntilHelp describes the until flag.
+	FlagUntilHelp = "only samples at or before this RFC3339 time"
+	// FlagExportSinceHelp describes the since flag for export.
+	FlagExportSinceHelp = "only samples within this look-back span (e.g. 24h, 7d); overrides -from"
+	// FlagWindowFilterHelp describes the window filter flag.
+	FlagWindowFilterHelp = "only this window: five_hour or seven_day (default all)"
+	// FlagExportFormatHelp describes the export format flag.
+	FlagExportFormatHelp = "output format: csv, tsv or jsonl"
+	// FlagTimeFormatHelp describes the time-format flag.
+	FlagTimeFormatHelp = "timestamp encoding: rfc3339 or epoch"
+	// FlagOutputHelp describes the output flag.
+	FlagOutputHelp = "write to this file instead of stdout"
+)
+
+// tmux integration flags and copy.
+const (
+	// CmdTmux is the subcommand that prints a tmux status snippet.
+	CmdTmux = "tmux"
+
+	// FlagRefreshName is the CLI flag name for the background refresher.
+	FlagRefreshName = "refresh"
+	// FlagMaxAgeName is the CLI flag name for the staleness cutoff.
+	FlagMaxAgeName = "max-age"
+	// FlagRemainingName is the CLI flag name for showing time until reset.
+	FlagRemainingName = "remaining"
+)
+
+// tmux flag help.
+var (
+	// FlagRefreshHelp describes the refresh flag.
+	FlagRefreshHelp = "keep polling and updating the snapshot instead of printing once"
+	// FlagMaxAgeHelp describes the max-age flag.
+	FlagMaxAgeHelp = "dim the output when the snapshot is older than this (0 never)"
+	// FlagRemainingHelp describes the remaining flag.
+	FlagRemainingHelp = "append the time left until each window resets"
+)
+
+// Status bar output shared by tmux and the bar subcommand.
+const (
+	// CmdBar is the subcommand that streams status bar lines.
+	CmdBar = "bar"
+
+	// FormatWaybar selects Waybar custom module JSON.
+	FormatWaybar = "waybar"
+	// FormatI3Blocks selects one i3bar block per line for i3blocks format=json.
+	FormatI3Blocks = "i3blocks"
+	// FormatI3Bar selects the full i3bar protocol.
+	FormatI3Bar = "i3bar"
+	// FormatPolybar selects polybar formatting tags.
+	FormatPolybar = "polybar"
+
+	// BarLabelFiveHour labels the 5-hour window in status bars.
+	BarLabelFiveHour = "5h"
+	// BarLabelSevenDay labels the 7-day window in status bars.
+	BarLabelSevenDay = "7d"
+	// BarSegmentFmt formats one window: label and percentage.
+	BarSegmentFmt = "%s %.0f%%"
+	// TextBarNoData is printed when no usage data is available.
+	TextBarNoData = "claude ?"
+
+	// BarClassNormal marks utilization below the threshold.
+	BarClassNormal = "normal"
+	// BarClassWarning marks utilization at or above the threshold.
+	BarClassWarning = "warning"
+	// BarClassCritical marks a window at its limit.
+	BarClassCritical = "critical"
+	// BarClassStale marks data kept from an earlier poll.
+	BarClassStale = "stale"
+	// BarClassError marks a failure with no data to show.
+	BarClassError = "error"
+)
+
+// Status bar flag help.
+var (
+	// FlagBarFormatHelp describes the bar format flag.
+	FlagBarFormatHelp = "status bar format: waybar, i3blocks, i3bar or polybar"
+)
+
+// Monitoring check subcommand.
+const (
+	// CmdCheck is the subcommand that runs a single Nagios-style check.
+	CmdCheck = "check"
+	// FlagWarnName is the CLI flag name for the warning threshold.
+	FlagWarnName = "warn"
+	// FlagCritName is the CLI flag name for the critical threshold.
+	FlagCritName = "crit"
+
+	// CheckLineFmt formats the summary: status word and details.
+	CheckLineFmt = "CLAUDE USAGE %s - %s"
+	// CheckWindowFmt formats one window in the summary: label and percentage.
+	CheckWindowFmt = "%s %.1f%%"
+	// CheckOverFmt marks a window that crossed a threshold.
+	CheckOverFmt = " %s"
+	// CheckPerfFmt formats perfdata: key, value, warn, crit, min and max.
+	CheckPerfFmt = "%s=%.1f%%;%s;%s;0;100"
+)
+
+// Monitoring check flag help.
+var (
+	// FlagWarnHelp describes the warn flag.
+	FlagWarnHelp = "warning utilization percent (default 80); a number or window=value pairs (e.g. 80 or five_hour=70,seven_day=85)"
+	// FlagCritHelp describes the crit flag.
+	FlagCritHelp = "critical utilization percent (default 95); a number or window=value pairs (e.g. 95 or seven_day=90)"
+)
+
+// Quota gate (wait) subcommand.
+const (
+	// CmdWait is the subcommand that blocks until quota is available.
+	CmdWait = "wait"
+	// FlagBelowName is the CLI flag name for the utilization gate.
+	FlagBelowName = "below"
+	// FlagNoWaitName is the CLI flag name that disables waiting.
+	FlagNoWaitName = "no-wait"
+)
+
+// Quota gate copy.
+var (
+	// FlagBelowHelp describes the below flag.
+	FlagBelowHelp = "proceed once utilization is below this percent"
+	// FlagWaitWindowHelp describes the wait window flag.
+	FlagWaitWindowHelp = "window to gate on: five_hour or seven_day"
+	// FlagNoWaitHelp describes the no-wait flag.
+	FlagNoWaitHelp = "exit non-zero immediately instead of waiting"
+
+	// TextWaitStatusFmt describes a blocked window: label, utilization, limit.
+	TextWaitStatusFmt = "%s at %.1f%% (limit %.0f%%)"
+	// TextWaitFmt reports a blocked check: status and time until the next one.
+	TextWaitFmt = "waiting: %s; checking again in %s"
+	// TextWaitErrorFmt reports a failed check: error and retry delay.
+	TextWaitErrorFmt = "waiting: %s; retrying in %s"
+	// TextWaitResetPassed reports proceeding because the reported utilization
+	// belongs to a window whose reset has already passed.
+	TextWaitResetPassed = "window has reset; proceeding"
+	// TextWaitResetPassedFmt reports proceeding after a reset despite an error.
+	TextWaitResetPassedFmt = "window has reset; proceeding (%s)"
+	// TextWaitNoData reports proceeding because the window has no data.
+	TextWaitNoData = "no utilization data for window; proceeding"
+)
+
+// Doctor subcommand and its limits.
+const (
+	// CmdDoctor is the subcommand that diagnoses setup problems.
+	CmdDoctor = "doctor"
+	// FormatJSON selects machine-readable JSON output.
+	FormatJSON = "json"
+
+	// ClockSkewLimit is the clock difference from the API server tolerated
+	// before doctor warns.
+	ClockSkewLimit = 30 * time.Second
+	// TokenExpirySoon is how close to expiry a token triggers a warning.
+	TokenExpirySoon = 10 * time.Minute
+)
+
+// Doctor check identifiers, the stable "name" of each check in JSON output.
+const (
+	// DoctorIDTokenSource identifies the env-vs-file precedence check.
+	DoctorIDTokenSource = "token_source"
+	// DoctorIDCredsFile identifies the credentials file existence check.
+	DoctorIDCredsFile = "credentials_file"
+	// DoctorIDCredsPerms identifies the 0600 permission check.
+	DoctorIDCredsPerms = "credentials_permissions"
+	// DoctorIDCredsJSON identifies the credentials JSON shape check.
+	DoctorIDCredsJSON = "credentials_json"
+	// DoctorIDTokenExpiry identifies the token expiry check.
+	DoctorIDTokenExpiry = "token_expiry"
+	// DoctorIDProxy identifies the proxy environment check.
+	DoctorIDProxy = "proxy"
+	// DoctorIDDNS identifies the API host resolution check.
+	DoctorIDDNS = "dns"
+	// DoctorIDTLS identifies the TLS handshake check.
+	DoctorIDTLS = "tls"
+	// DoctorIDBeta identifies the beta header check.
+	DoctorIDBeta = "beta_header"
+	// DoctorIDAPI identifies the live usage request check.
+	DoctorIDAPI = "api_request"
+	// DoctorIDClock identifies the clock skew check.
+	DoctorIDClock = "clock_skew"
+)
+
+// Doctor check names, details, and remediation hints.
+var (
+	// FlagDoctorFormatHelp describes the doctor format flag.
+	FlagDoctorFormatHelp = "output format: text or json"
+
+	// DoctorCheckTokenSource names the env-vs-file precedence check.
+	DoctorCheckTokenSource = "token source"
+	// DoctorCheckCredsFile names the credentials file existence check.
+	DoctorCheckCredsFile = "credentials file"
+	// DoctorCheckCredsPerms names the 0600 permission check.
+	DoctorCheckCredsPerms = "credentials permissions"
+	// DoctorCheckCredsJSON names the credentials JSON shape check.
+	DoctorCheckCredsJSON = "credentials JSON"
+	// DoctorCheckTokenExpiry names the token expiry check.
+	DoctorCheckTokenExpiry = "token expiry"
+	// DoctorCheckProxy names the proxy environment check.
+	DoctorCheckProxy = "proxy"
+	// DoctorCheckDNS names the API host resolution check.
+	DoctorCheckDNS = "DNS"
+	// DoctorCheckTLS names the TLS handshake check.
+	DoctorCheckTLS = "TLS"
+	// DoctorCheckBeta names the beta header check.
+	DoctorCheckBeta = "beta header"
+	// DoctorCheckAPI names the live usage request check.
+	DoctorCheckAPI = "API request"
+	// DoctorCheckClock names the clock skew check.
+	DoctorCheckClock = "clock skew"
+
+	// TextDoctorTokenEnv reports an environment token.
+	TextDoctorTokenEnv = EnvTokenName + " is set; the credentials file is ignored"
+	// TextDoctorTokenFileFmt reports a file token: path.
+	TextDoctorTokenFileFmt = EnvTokenName + " is not set; using %s"
+	// TextDoctorEnvIgnoredFmt reports an unused credentials file: env var, path.
+	TextDoctorEnvIgnoredFmt = "not used (%s takes precedence): %s"
+	// TextDoctorFileMissingFmt reports an unreadable file: path, error.
+	TextDoctorFileMissingFmt = "%s: %v"
+	// TextDoctorFileOK reports an existing file: path.
+	TextDoctorFileOK = "%s exists"
+	// TextDoctorModeOKFmt reports acceptable permissions.
+	TextDoctorModeOKFmt = "mode %04o"
+	// TextDoctorModeBadFmt reports permissions open to group/other.
+	TextDoctorModeBadFmt = "mode %04o allows group/other access"
+	// TextDoctorJSONBadFmt reports a JSON parse error.
+	TextDoctorJSONBadFmt = "not valid JSON: %v"
+	// TextDoctorJSONNoToken reports a missing access token field.
+	TextDoctorJSONNoToken = "claudeAiOauth.accessToken is missing or empty"
+	// TextDoctorJSONOK reports a well-formed credentials file.
+	TextDoctorJSONOK = "claudeAiOauth.accessToken present"
+	// TextDoctorExpiryUnknown explains a skipped expiry check.
+	TextDoctorExpiryUnknown = "no expiresAt recorded"
+	// TextDoctorExpiredFmt reports an expired token: age, timestamp.
+	TextDoctorExpiredFmt = "expired %s ago (%s)"
+	// TextDoctorExpiresFmt reports a valid token: time left, timestamp.
+	TextDoctorExpiresFmt = "expires in %s (%s)"
+	// TextDoctorExpiryEnv explains why env tokens have no expiry.
+	TextDoctorExpiryEnv = "unknown for tokens supplied via " + EnvTokenName
+	// TextDoctorSkipped formats a skipped check: reason.
+	TextDoctorSkipped = "skipped: %s"
+	// TextDoctorNoProxy reports a direct connection.
+	TextDoctorNoProxy = "no proxy configured"
+	// TextDoctorProxyFmt reports the proxy in use: URL, variables.
+	TextDoctorProxyFmt = "requests go via %s (%s)"
+	// TextDoctorProxyBadFmt reports an unparsable proxy setting.
+	TextDoctorProxyBadFmt = "invalid proxy setting: %v"
+	// TextDoctorProxyBypassFmt reports a NO_PROXY match: host, variables.
+	TextDoctorProxyBypassFmt = "%s bypasses the proxy (%s)"
+	// TextDoctorDNSFmt reports resolved addresses: host, addresses.
+	TextDoctorDNSFmt = "%s resolves to %s"
+	// TextDoctorTLSFmt reports a handshake: TLS version, issuer.
+	TextDoctorTLSFmt = "%s handshake OK, certificate issued by %s"
+	// TextDoctorBetaEmpty reports an empty beta header.
+	TextDoctorBetaEmpty = "no beta header configured"
+	// TextDoctorBetaDefaultFmt reports the baked-in beta header.
+	TextDoctorBetaDefaultFmt = "using the baked-in default %q"
+	// TextDoctorBetaCustomFmt reports a configured beta header.
+	TextDoctorBetaCustomFmt = "using %q"
+	// TextDoctorAPIOKFmt reports a successful request: window count.
+	TextDoctorAPIOKFmt = "HTTP 200, %s returned"
+	// TextDoctorWindows counts the usage windows in a response.
+	TextDoctorWindows = Plural{One: "%d usage window", Other: "%d usage windows"}
+	// TextDoctorClockOKFmt reports skew within the limit.
+	TextDoctorClockOKFmt = "local clock within %s of the server"
+	// TextDoctorClockAheadFmt reports a fast local clock.
+	TextDoctorClockAheadFmt = "local clock is %s ahead of the server"
+	// TextDoctorClockBehindFmt reports a slow local clock.
+	TextDoctorClockBehindFmt = "local clock is %s behind the server"
+	// TextDoctorNoDate explains a skipped clock check.
+	TextDoctorNoDate = "server sent no Date header"
+	// TextDoctorNoToken is the skip reason when no token resolves.
+	TextDoctorNoToken = "no token"
+	// TextDoctorNoDNS is the skip reason when DNS failed.
+	TextDoctorNoDNS = "DNS lookup failed"
+	// TextDoctorNoResponse is the skip reason when the API never answered.
+	TextDoctorNoResponse = "no API response"
+	// TextDoctorNoFile is the skip reason when the credentials file is unusable.
+	TextDoctorNoFile = "credentials file unreadable"
+	// TextDoctorSummaryFmt summarizes counts: ok, warnings, failures.
+	TextDoctorSummaryFmt = "%d ok, %s, %d failed"
+	// TextDoctorWarnings counts the warnings in the summary.
+	TextDoctorWarnings = Plural{One: "%d warning", Other: "%d warnings"}
+
+	// HintDoctorTokenMissing remedies an unresolvable token.
+	HintDoctorTokenMissing = "log in with Claude Code, or export " + EnvTokenName
+	// HintDoctorCredsPath remedies a missing credentials file.
+	HintDoctorCredsPath = "pass -creds with the right path, or export " + EnvTokenName
+	// HintDoctorChmodFmt remedies loose permissions: path.
+	HintDoctorChmodFmt = "chmod 600 %s"
+	// HintDoctorCredsJSON remedies a malformed credentials file.
+	HintDoctorCredsJSON = "re-run Claude Code login to rewrite the file"
+	// HintDoctorTokenExpired remedies an expired token.
+	HintDoctorTokenExpired = "open Claude Code to refresh the token, or export a fresh " + EnvTokenName
+	// HintDoctorProxy remedies a bad proxy setting.
+	HintDoctorProxy = "fix HTTPS_PROXY/HTTP_PROXY; values must be URLs such as http://host:3128"
+	// HintDoctorDNS remedies a resolution failure.
+	HintDoctorDNS = "check your network and resolver; behind a proxy, DNS may only work through it"
+	// HintDoctorTLS remedies a handshake failure.
+	HintDoctorTLS = "a firewall or TLS-intercepting proxy may be in the way; set SSL_CERT_FILE to its CA bundle"
+	// HintDoctorBetaEmpty remedies an empty beta header.
+	HintDoctorBetaEmpty = "pass -beta-header or set " + EnvBetaHeader
+	// HintDoctorBetaDefault explains the baked-in beta header risk.
+	HintDoctorBetaDefault = "if requests fail with 400/401/403, set a current value via -beta-header or " + EnvBetaHeader
+	// HintDoctorAPIAuth remedies a 401.
+	HintDoctorAPIAuth = "the token was rejected; refresh it by opening Claude Code, or check the beta header"
+	// HintDoctorAPIBeta remedies other rejected requests.
+	HintDoctorAPIBeta = "the request was rejected; the beta header may be outdated"
+	// HintDoctorAPIRate remedies a 429.
+	HintDoctorAPIRate = "rate limited; increase -interval"
+	// HintDoctorAPINetwork remedies transport errors.
+	HintDoctorAPINetwork = "check connectivity, proxy settings and -http-timeout"
+	// HintDoctorClock remedies clock skew.
+	HintDoctorClock = "enable NTP time sync; reset times and countdowns depend on the local clock"
+)
+
+// Command-line structure: command names, synopses, and flag names.
+const (
+	// ProgramName is the binary name used in help and completion scripts.
+	ProgramName = "claude-monitor"
+
+	// CmdTUI is the interactive dashboard, run when no command is given.
+	CmdTUI = "tui"
+	// CmdStatus prints current usage once.
+	CmdStatus = "status"
+	// CmdWatch prints usage after every poll.
+	CmdWatch = "watch"
+	// CmdServe exposes usage over HTTP.
+	CmdServe = "serve"
+	// CmdConfig prints the effective configuration.
+	CmdConfig = "config"
+	// CmdVersion prints build information.
+	CmdVersion = "version"
+	// CmdCompletion prints a shell completion script.
+	CmdCompletion = "completion"
+	// CmdHelp prints top-level or per-command help.
+	CmdHelp = "help"
+
+	// ArgsWait is the positional synopsis of the wait command.
+	ArgsWait = "[-- command [args...]]"
+	// ArgsCompletion is the positional synopsis of the completion command.
+	ArgsCompletion = "bash|zsh|fish"
+	// ArgsHelp is the positional synopsis of the help command.
+	ArgsHelp = "[command]"
+
+	// FlagAddrName is the CLI flag name for the serve listen address.
+	FlagAddrName = "addr"
+	// FlagWidthName is the CLI flag name for output width.
+	FlagWidthName = "width"
+	// TextVersionFmt formats the version line: program, version.
+	TextVersionFmt = "%s %s"
+)
+
+// Command summaries and help copy.
+var (
+	// SummaryTUI describes the tui command.
+	SummaryTUI = "interactive dashboard (default)"
+	// SummaryStatus describes the status command.
+	SummaryStatus = "print current usage once and exit"
+	// SummaryWatch describes the watch command.
+	SummaryWatch = "print usage after every poll, as log lines or a redrawn block"
+	// SummaryServe describes the serve command.
+	SummaryServe = "serve usage as JSON and Prometheus metrics over HTTP"
+	// SummaryReport describes the report command.
+	SummaryReport = "summarize recorded samples"
+	// SummaryExport describes the export command.
+	SummaryExport = "export recorded samples as CSV, TSV or JSON Lines"
+	// SummaryDoctor describes the doctor command.
+	SummaryDoctor = "diagnose credentials, network and API problems"
+	// SummaryConfig describes the config command.
+	SummaryConfig = "print the effective configuration"
+	// SummaryVersion describes the version command.
+	SummaryVersion = "print version information"
+	// SummaryCheck describes the check command.
+	SummaryCheck = "Nagios-style check with exit codes and perfdata"
+	// SummaryWait describes the wait command.
+	SummaryWait = "wait for quota, then run a command"
+	// SummaryTmux describes the tmux command.
+	SummaryTmux = "print a tmux status snippet from the snapshot"
+	// SummaryBar describes the bar command.
+	SummaryBar = "stream Waybar, i3blocks, i3bar or polybar lines"
+	// SummaryCompletion describes the completion command.
+	SummaryCompletion = "print a bash, zsh or fish completion script"
+	// SummaryHelp describes the help command.
+	SummaryHelp = "show help for a command"
+
+	// TextUsageFmt heads top-level help: program name.
+	TextUsageFmt = "Usage: %s [command] [flags]"
+	// TextCommandUsageFmt heads command help: program, command, synopsis.
+	TextCommandUsageFmt = "Usage: %s %s [flags] %s"
+	// TextCommandsTitle heads the command list.
+	TextCommandsTitle = "Commands:"
+	// TextFlagsTitle heads a flag list.
+	TextFlagsTitle = "Flags:"
+	// TextHelpFooterFmt closes top-level help: program name.
+	TextHelpFooterFmt = "Run '%s help <command>' for command flags. Without a command, tui runs."
+
+	// ErrUnknownCommandFmt formats an unknown command name.
+	ErrUnknownCommandFmt = "unknown command %q (run '" + ProgramName + " help')"
+	// ErrShellFmt formats an unsupported completion shell.
+	ErrShellFmt = "unknown shell %q (use bash, zsh or fish)"
+	// ErrShellRequired signals a missing completion shell argument.
+	ErrShellRequired = "shell required: bash, zsh or fish"
+	// FlagAddrHelp describes the addr flag.
+	FlagAddrHelp = "listen address"
+	// FlagStatusFormatHelp describes the status and config format flag.
+	FlagStatusFormatHelp = "output format: text or json"
+	// FlagWidthHelp describes the width flag.
+	FlagWidthHelp = "output width in columns"
+
+	// TextServeListeningFmt logs the serve address.
+	TextServeListeningFmt = "serving usage on http://%s (/usage, /metrics, /healthz)"
+)
+
+// Config command token sources, stable in JSON output.
+const (
+	// ConfigSourceEnv marks a token taken from the environment.
+	ConfigSourceEnv = "env"
+	// ConfigSourceFile marks a token read from the credentials file.
+	ConfigSourceFile = "credentials_file"
+)
+
+// Config command values.
+var (
+	// ConfigTokenEnv reports a token taken from the environment.
+	ConfigTokenEnv = "env (" + EnvTokenName + ")"
+	// ConfigTokenFile reports a token read from the credentials file.
+	ConfigTokenFile = "credentials file"
+)
+
+// Version command.
+const (
+	// FlagJSONName is the CLI flag name selecting JSON output.
+	FlagJSONName = "json"
+	// VersionRowFmt formats one tab-aligned version row: label, value.
+	VersionRowFmt = "  %s\t%s\n"
+)
+
+// Version command copy.
+var (
+	// FlagJSONHelp describes the json flag.
+	FlagJSONHelp = "print JSON instead of text"
+	// TextDirty marks builds with uncommitted changes.
+	TextDirty = "(dirty)"
+	// TextBetaRemembered marks a beta header taken from the state file.
+	TextBetaRemembered = "(remembered)"
+	// TextVersionRevision labels the VCS revision row.
+	TextVersionRevision = "revision"
+	// TextVersionCommitTime labels the commit time row.
+	TextVersionCommitTime = "commit time"
+	// TextVersionGo labels the Go version row.
+	TextVersionGo = "go"
+	// TextVersionBeta labels the effective beta header row.
+	TextVersionBeta = "beta header"
+	// TextVersionAPI labels the API base URL row.
+	TextVersionAPI = "api"
+)
+
+// Demo command.
+const (
+	// CmdDemo runs the TUI against the scripted fake API.
+	CmdDemo = "demo"
+	// FlagLoopName is the CLI flag name for repeating the demo script.
+	FlagLoopName = "loop"
+	// DemoToken is the bearer token sent to the fake API.
+	DemoToken = "demo-token"
+)
+
+// Demo command copy.
+var (
+	// SummaryDemo describes the demo command.
+	SummaryDemo = "run the dashboard against a scripted offline API"
+	// FlagLoopHelp describes the loop flag.
+	FlagLoopHelp = "restart the script after the last step"
+)
+
+// Snapshot command.
+const (
+	// CmdSnapshot renders one dashboard frame to a file.
+	CmdSnapshot = "snapshot"
+	// FormatANSI selects raw terminal output with escape sequences.
+	FormatANSI = "ansi"
+	// FormatHTML selects an HTML fragment.
+	FormatHTML = "html"
+	// FormatSVG selects an SVG image.
+	FormatSVG = "svg"
+	// FlagAtName is the CLI flag name for the render clock.
+	FlagAtName = "at"
+	// FlagColorName is the CLI flag name for the color profile.
+	FlagColorName = "color"
+	// FlagLightName is the CLI flag name for light background rendering.
+	FlagLightName = "light"
+	// FlagInputName is the CLI flag name for a recorded sample file.
+	FlagInputName = "input"
+)
+
+// Snapshot command copy.
+var (
+	// SummarySnapshot describes the snapshot command.
+	SummarySnapshot = "render the dashboard once as text, ANSI, HTML or SVG"
+	// FlagSnapshotFormatHelp describes the snapshot format flag.
+	FlagSnapshotFormatHelp = "output format: text, ansi, html or svg"
+	// FlagAtHelp describes the at flag.
+	FlagAtHelp = "render as of this RFC3339 time (default: the fetch time)"
+	// FlagColorHelp describes the color flag.
+	FlagColorHelp = "color profile: ascii, ansi, ansi256 or truecolor (default: truecolor, ascii for text)"
+	// FlagLightHelp describes the light flag.
+	FlagLightHelp = "render for a light background"
+	// FlagInputHelp describes the input flag.
+	FlagInputHelp = "render this sample JSON (as printed by 'status -format json') instead of fetching"
+
+	// ErrColorProfileFmt formats an unknown color profile.
+	ErrColorProfileFmt = "unknown color profile %q (use ascii, ansi, ansi256 or truecolor)"
+	// ErrSnapshotWidthFmt signals a width below the layout minimum.
+	ErrSnapshotWidthFmt = "width must be at least %d"
+	// ErrSampleFileFmt wraps a sample file that cannot be read or parsed.
+	ErrSampleFileFmt = "read sample %s: %w"
+)
+
+// Snapshot color profiles.
+const (
+	// ColorProfileASCII disables colors.
+	ColorProfileASCII = "ascii"
+	// ColorProfileANSI limits colors to the 16 basic ones.
+	ColorProfileANSI = "ansi"
+	// ColorProfileANSI256 limits colors to the 256-color palette.
+	ColorProfileANSI256 = "ansi256"
+	// ColorProfileTrueColor keeps 24-bit colors.
+	ColorProfileTrueColor = "truecolor"
+)
+
+// Themes.
+const (
+	// ThemeDark is the default built-in theme.
+	ThemeDark = "dark"
+	// ThemeLight is tuned for light terminal backgrounds.
+	ThemeLight = "light"
+	// ThemeSolarized follows the Solarized palette.
+	ThemeSolarized = "solarized"
+	// ThemeColorblind uses the Okabe–Ito colorblind-safe palette.
+	ThemeColorblind = "colorblind"
+	// ThemeHighContrast is selected by CLAUDE_MONITOR_HIGH_CONTRAST.
+	ThemeHighContrast = "high-contrast"
+	// ThemeDirName names the user theme directory under the config dir.
+	ThemeDirName = "themes"
+
+	// BorderRounded draws boxes with rounded corners.
+	BorderRounded = "rounded"
+	// BorderNormal draws boxes with square corners.
+	BorderNormal = "normal"
+	// BorderThick draws boxes with heavy lines.
+	BorderThick = "thick"
+	// BorderDouble draws boxes with double lines.
+	BorderDouble = "double"
+	// BorderBlock draws boxes with full blocks.
+	BorderBlock = "block"
+	// BorderHidden keeps the box spacing without visible lines.
+	BorderHidden = "hidden"
+
+	// EnvTheme names the env var supplying the default -theme.
+	EnvTheme = "CLAUDE_MONITOR_THEME"
+	// EnvHighContrast names the env var selecting the high-contrast theme.
+	EnvHighContrast = "CLAUDE_MONITOR_HIGH_CONTRAST"
+
+	// FlagThemeName is the CLI flag name for the theme.
+	FlagThemeName = "theme"
+	// FlagThemeDirName is the CLI flag name for the user theme directory.
+	FlagThemeDirName = "theme-dir"
+
+	// HelpThemeKey cycles through the available themes.
+	HelpThemeKey = "t"
+)
+
+// Theme copy.
+var (
+	// FlagThemeHelp describes the theme flag.
+	FlagThemeHelp = "theme name (dark, light, solarized, colorblind, high-contrast or a user theme) or path to a theme file"
+	// FlagThemeDirHelp describes the theme-dir flag.
+	FlagThemeDirHelp = "directory of *.json theme files added to the cycle (empty disables)"
+	// HelpThemeDesc describes the theme shortcut.
+	HelpThemeDesc = "theme"
+	// TextThemeFmt reports the active theme after cycling: name.
+	TextThemeFmt = "theme %s"
+
+	// ErrThemeFileFmt wraps a theme file problem: path, cause.
+	ErrThemeFileFmt = "theme %s: %w"
+	// ErrThemeMissingFmt names a missing palette entry.
+	ErrThemeMissingFmt = "missing color %q"
+	// ErrThemeColorFmt names an invalid color: entry, value.
+	ErrThemeColorFmt = "invalid color for %q: %q (use #rrggbb or 0-255)"
+	// ErrThemeColorShape signals a color that is neither a string nor a light/dark pair.
+	ErrThemeColorShape = `color must be "#rrggbb" or {"light": ..., "dark": ...}`
+	// ErrThemeBorderFmt names an unknown border style.
+	ErrThemeBorderFmt = "unknown border %q (use rounded, normal, thick, double, block or hidden)"
+	// ErrThemeUnknownFmt names an unknown theme: name, available names.
+	ErrThemeUnknownFmt = "unknown theme %q (available: %s)"
+)
+
+// Severity bands.
+const (
+	// BandsNone disables severity bands.
+	BandsNone = "none"
+	// FlagBandsName is the CLI flag name for the severity bands.
+	FlagBandsName = "bands"
+	// FlagGradientName is the CLI flag name for gradient bar fills.
+	FlagGradientName = "gradient"
+)
+
+// Severity band copy.
+var (
+	// FlagBandsHelp describes the bands flag.
+	FlagBandsHelp = `severity bands "warn,crit" in percent, or "none" (default: 50 and the threshold)`
+	// FlagGradientHelp describes the gradient flag.
+	FlagGradientHelp = "color each bar cell by its position across the bands"
+	// ErrBandsFmt formats an invalid bands value.
+	ErrBandsFmt = `invalid bands %q (use "warn,crit" with 0 <= warn <= crit <= 100, or "none")`
+)
+
+// Keybindings.
+const (
+	// KeysFileName names the keybinding file under the config dir.
+	KeysFileName = "keys.json"
+	// FlagKeysName is the CLI flag name for the keybinding file.
+	FlagKeysName = "keys"
+
+	// KeyActionRefresh through KeyActionLive name the remappable actions in
+	// the keybinding file.
+	KeyActionRefresh  = "refresh"
+	KeyActionHistory  = "history"
+	KeyActionBack     = "back"
+	KeyActionPause    = "pause"
+	KeyActionFaster   = "faster"
+	KeyActionSlower   = "slower"
+	KeyActionTheme    = "theme"
+	KeyActionHelp     = "help"
+	KeyActionQuit     = "quit"
+	KeyActionRange1   = "range_1"
+	KeyActionRange2   = "range_2"
+	KeyActionRange3   = "range_3"
+	KeyActionRange4   = "range_4"
+	KeyActionZoomIn   = "zoom_in"
+	KeyActionZoomOut  = "zoom_out"
+	KeyActionPanLeft  = "pan_left"
+	KeyActionPanRight = "pan_right"
+	KeyActionLive     = "live"
+
+	// HelpOverlayKey opens and closes the full help overlay.
+	HelpOverlayKey = "?"
+	// HelpEllipsis marks a short help line truncated to fit.
+	HelpEllipsis = "…"
+	// HelpColumnGap separates columns of the full help.
+	HelpColumnGap = "    "
+)
+
+// Keybinding help and errors.
+var (
+	// FlagKeysHelp describes the keys flag.
+	FlagKeysHelp = "JSON file remapping keys, e.g. {\"history\": [\"g\"]} (empty disables)"
+	// HelpOverlayDesc describes the help overlay shortcut.
+	HelpOverlayDesc = "help"
+	// HelpOverlayTitle heads the full help overlay.
+	HelpOverlayTitle = "Keyboard shortcuts"
+	// HelpOverlayHint closes the full help overlay.
+	HelpOverlayHint = "press ? or esc to close"
+
+	// HelpRefreshLongDesc through HelpLiveDesc describe each action in the
+	// full help.
+	HelpRefreshLongDesc = "refresh now"
+	HelpHistoryLongDesc = "history chart"
+	HelpBackLongDesc    = "close chart or help"
+	HelpPauseLongDesc   = "pause or resume polling"
+	HelpFasterDesc      = "poll more often"
+	HelpSlowerDesc      = "poll less often"
+	HelpThemeLongDesc   = "next theme"
+	HelpOverlayLongDesc = "toggle this help"
+	HelpQuitLongDesc    = "quit"
+	HelpRangeFmt        = "show last %s"
+	HelpZoomInDesc      = "zoom in"
+	HelpZoomOutDesc     = "zoom out"
+	HelpPanLeftDesc     = "pan back"
+	HelpPanRightDesc    = "pan forward"
+	HelpLiveDesc        = "jump to now"
+
+	// ErrKeysFileFmt wraps a keybinding file problem: path, cause.
+	ErrKeysFileFmt = "keys %s: %w"
+	// ErrKeysActionFmt names an unknown action: action, available actions.
+	ErrKeysActionFmt = "unknown action %q (available: %s)"
+	// ErrKeysEmptyFmt names an action bound to a blank key.
+	ErrKeysEmptyFmt = "action %q has an empty key"
+	// ErrKeysConflictFmt names a key bound twice in one view: key, actions.
+	ErrKeysConflictFmt = "key %q is bound to both %s and %s"
+	// ErrKeysQuitRequired rejects a map that leaves no way to quit.
+	ErrKeysQuitRequired = "quit needs at least one key"
+)
+
+// Layouts.
+const (
+	// LayoutAutoName picks the richest layout that fits the window height.
+	LayoutAutoName = "auto"
+	// LayoutFullName shows the logo, bordered chart, and help footer.
+	LayoutFullName = "full"
+	// LayoutCompactName shows one line per window plus a status line.
+	LayoutCompactName = "compact"
+	// LayoutMinimalName fits everything on a single line.
+	LayoutMinimalName = "minimal"
+	// FlagLayoutName is the CLI flag name for the layout.
+	FlagLayoutName = "layout"
+)
+
+// Layout copy.
+var (
+	// FlagLayoutHelp describes the layout flag.
+	FlagLayoutHelp = "dashboard layout: auto, full, compact or minimal (auto picks by window height)"
+	// ErrLayoutFmt formats an unknown layout name.
+	ErrLayoutFmt = "unknown layout %q (use auto, full, compact or minimal)"
+)
+
+// Watch command.
+const (
+	// WatchModeLog appends one timestamped line per poll.
+	WatchModeLog = "log"
+	// WatchModeBlock prints a compact dashboard block per poll.
+	WatchModeBlock = "block"
+	// FlagWatchModeName is the CLI flag name for the watch mode.
+	FlagWatchModeName = "mode"
+	// FlagAppendName is the CLI flag name for appending blocks on a terminal.
+	FlagAppendName = "append"
+	// WatchRewindFmt moves the cursor up over the previous block (line count)
+	// and clears to the end of the screen.
+	WatchRewindFmt = "\x1b[%dA\r\x1b[J"
+)
+
+// Watch command copy.
+var (
+	// FlagWatchModeHelp describes the watch mode flag.
+	FlagWatchModeHelp = "output: log (one line per poll) or block (bars redrawn in place on a terminal, appended otherwise)"
+	// FlagAppendHelp describes the append flag.
+	FlagAppendHelp = "append blocks instead of redrawing them, even on a terminal"
+	// ErrWatchModeFmt formats an unknown watch mode.
+	ErrWatchModeFmt = "unknown watch mode %q (use log or block)"
+)
+
+// Event log.
+const (
+	// HelpEventsKey toggles the event log.
+	HelpEventsKey = "e"
+	// KeyActionEvents through KeyActionBottom name the event log actions in
+	// the keybinding file.
+	KeyActionEvents     = "events"
+	KeyActionScrollUp   = "scroll_up"
+	KeyActionScrollDown = "scroll_down"
+	KeyActionPageUp     = "page_up"
+	KeyActionPageDown   = "page_down"
+	KeyActionTop        = "top"
+	KeyActionBottom     = "bottom"
+	// EventsScrollUpKey through EventsBottomKey are the default event log
+	// scroll keys; the Alt keys are the pager-style alternatives.
+	EventsScrollUpKey      = "up"
+	EventsScrollUpAltKey   = "k"
+	EventsScrollDownKey    = "down"
+	EventsScrollDownAltKey = "j"
+	EventsPageUpKey        = "pgup"
+	EventsPageUpAltKey     = "b"
+	EventsPageDownKey      = "pgdown"
+	EventsPageDownAltKey   = " "
+	EventsTopKey           = "home"
+	EventsBottomKey        = "end"
+	// EventsScrollUpSymbol, EventsScrollDownSymbol and EventsSpaceSymbol show
+	// keys in help.
+	EventsScrollUpSymbol   = "↑"
+	EventsScrollDownSymbol = "↓"
+	EventsSpaceSymbol      = "space"
+	// EventTimeLayout formats event timestamps.
+	EventTimeLayout = "15:04:05"
+	// EventMarkFetch through EventMarkCrossDown prefix each event kind.
+	EventMarkFetch     = "✓"
+	EventMarkError     = "✗"
+	EventMarkBackoff   = "…"
+	EventMarkReset     = "↺"
+	EventMarkCrossUp   = "▲"
+	EventMarkCrossDown = "▼"
+)
+
+// Event log copy.
+var (
+	// HelpEventsDesc describes the event log shortcut.
+	HelpEventsDesc = "events"
+	// HelpEventsLongDesc describes the event log shortcut in the full help.
+	HelpEventsLongDesc = "event log"
+	// HelpScrollDesc through HelpBottomDesc describe the scroll keys.
+	HelpScrollDesc   = "scroll"
+	HelpPageDesc     = "page"
+	HelpScrollUpDesc = "scroll up"
+	HelpScrollDnDesc = "scroll down"
+	HelpPageUpDesc   = "page up"
+	HelpPageDnDesc   = "page down"
+	HelpTopDesc      = "oldest"
+	HelpBottomDesc   = "newest"
+
+	// TextEventsTitle heads the event log.
+	TextEventsTitle = "Events"
+	// TextEventsCountFmt follows the title: number of events kept.
+	TextEventsCountFmt = "%d kept"
+	// TextEventsEmpty shows before anything happened.
+	TextEventsEmpty = "No events yet."
+	// TextEventFetchFmt describes a successful fetch: latency, usage summary.
+	TextEventFetchFmt = "fetched in %s · %s"
+	// TextEventErrorFmt describes a failed fetch: latency, error.
+	TextEventErrorFmt = "failed after %s: %s"
+	// TextEventRetryAfterFmt is appended when the server sent Retry-After.
+	TextEventRetryAfterFmt = " (Retry-After %s)"
+	// TextEventBackoffFmt describes the retry delay: delay, failure count.
+	TextEventBackoffFmt = "retrying in %s (failure %d)"
+	// TextEventResetFmt describes a window rollover: window, previous percent.
+	TextEventResetFmt = "%s window reset (was %.0f%%)"
+	// TextEventCrossUpFmt describes rising past the threshold: window,
+	// threshold, percent.
+	TextEventCrossUpFmt = "%s crossed %.0f%% (now %.0f%%)"
+	// TextEventCrossDownFmt describes falling below the threshold: window,
+	// threshold, percent.
+	TextEventCrossDownFmt = "%s back under %.0f%% (now %.0f%%)"
+)
+
+// Mouse and row details.
+const (
+	// FlagMouseName is the CLI flag name for mouse capture.
+	FlagMouseName = "mouse"
+)
+
+// Row detail copy.
+var (
+	// FlagMouseHelp describes the mouse flag.
+	FlagMouseHelp = "capture the mouse: click a row for details, click the footer to refresh, wheel through views (disable to select text)"
+	// DetailExactLabel through DetailChangeLabel name the detail panel lines.
+	DetailExactLabel   = "exact"
+	DetailResetsLabel  = "resets"
+	DetailStartedLabel = "started"
+	DetailPaceLabel    = "pace"
+	DetailChangeLabel  = "last change"
+	// DetailExactFmt shows the unrounded utilization.
+	DetailExactFmt = "%.2f%%"
+	// DetailBothZonesFmt joins the local and UTC reset times.
+	DetailBothZonesFmt = "%s · %s"
+	// DetailPaceFmt compares usage with an even spend over the window:
+	// ratio, projected percent at reset.
+	DetailPaceFmt = "%.1f× even pace · %.0f%% projected at reset"
+	// DetailPaceEarly replaces the pace while too little of the window has
+	// passed to project from.
+	DetailPaceEarly = "too early to tell"
+	// DetailChangeFmt describes the last utilization change: delta, age.
+	DetailChangeFmt = "%+.1f%% · %s ago"
+	// DetailChangeNone shows when utilization has not moved this session.
+	DetailChangeNone = "none this session"
+	// DetailUnknown stands in for values the API did not send.
+	DetailUnknown = "—"
+)
+
+// Languages.
+const (
+	// LangEnglish through LangJapanese name the shipped catalogs.
+	LangEnglish  = "en"
+	LangGerman   = "de"
+	LangJapanese = "ja"
+	// FlagLangName is the CLI flag name for the language override.
+	FlagLangName = "lang"
+	// EnvLCAll, EnvLCMessages and EnvLang are checked in this order to
+	// detect the language.
+	EnvLCAll      = "LC_ALL"
+	EnvLCMessages = "LC_MESSAGES"
+	EnvLang       = "LANG"
+)
+
+// Language copy.
+var (
+	// FlagLangHelp describes the lang flag.
+	FlagLangHelp = "language: en, de or ja (default from LC_ALL, LC_MESSAGES or LANG)"
+	// ErrLangFmt formats an unsupported language.
+	ErrLangFmt = "unknown language %q (use en, de or ja)"
+)
+
+// Reset time format.
+const (
+	// FlagClockName is the CLI flag name for the 12/24-hour clock.
+	FlagClockName = "clock"
+	// FlagTZName is the CLI flag name for the reset time zone.
+	FlagTZName = "tz"
+	// FlagUTCName is the CLI flag name for adding UTC to reset times.
+	FlagUTCName = "utc"
+	// FlagResetTimeName is the CLI flag name choosing absolute and/or
+	// relative reset times.
+	FlagResetTimeName = "reset-time"
+
+	// Clock24h and Clock12h are the -clock values.
+	Clock24h = "24h"
+	Clock12h = "12h"
+	// ResetTimeBoth, ResetTimeAbsolute and ResetTimeRelative are the
+	// -reset-time values.
+	ResetTimeBoth     = "both"
+	ResetTimeAbsolute = "absolute"
+	ResetTimeRelative = "relative"
+)
+
+// Reset time copy.
+var (
+	// ResetTimeLayout formats resets later the same day.
+	ResetTimeLayout = "15:04 MST"
+	// ResetDayLayout formats resets within a day but on another date.
+	ResetDayLayout = "Mon 15:04 MST"
+	// ResetDateLayout formats resets more than a day away.
+	ResetDateLayout = "Mon Jan 02 15:04 MST"
+	// ResetTime12Layout through ResetDate12Layout are the 12-hour clock
+	// forms of the layouts above.
+	ResetTime12Layout = "3:04 PM MST"
+	ResetDay12Layout  = "Mon 3:04 PM MST"
+	ResetDate12Layout = "Mon Jan 02 3:04 PM MST"
+	// TextResetUTCFmt appends the UTC time: zone time, UTC time.
+	TextResetUTCFmt = "%s (%s)"
+
+	// FlagClockHelp describes the clock flag.
+	FlagClockHelp = "clock for reset times: 24h or 12h"
+	// FlagTZHelp describes the tz flag.
+	FlagTZHelp = "IANA time zone for reset times, e.g. Europe/Berlin (default: local)"
+	// FlagUTCHelp describes the utc flag.
+	FlagUTCHelp = "show the UTC time next to each reset time"
+	// FlagResetTimeHelp describes the reset-time flag.
+	FlagResetTimeHelp = "reset times as both, absolute (clock time only) or relative (time left only)"
+	// ErrClockFmt formats an unknown clock.
+	ErrClockFmt = "unknown clock %q (use 24h or 12h)"
+	// ErrTZFmt wraps a time zone that cannot be loaded.
+	ErrTZFmt = "time zone %q: %w"
+	// ErrResetTimeFmt formats an unknown reset-time value.
+	ErrResetTimeFmt = "unknown reset time %q (use both, absolute or relative)"
 )
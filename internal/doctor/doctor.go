@@ -0,0 +1,396 @@
+package doctor
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"claude-monitor/internal/api"
+	"claude-monitor/internal/auth"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/utils"
+)
+
+// Status is the outcome of a single diagnostic check.
+type Status string
+
+const (
+	// StatusOK means the check passed.
+	StatusOK Status = "ok"
+	// StatusWarn flags a likely problem that does not block requests.
+	StatusWarn Status = "warn"
+	// StatusFail flags a problem that breaks monitoring.
+	StatusFail Status = "fail"
+	// StatusSkip means a prerequisite failed or the check does not apply.
+	StatusSkip Status = "skip"
+)
+
+// Check is one line of the doctor checklist.
+type Check struct {
+	// Name is the check's stable identifier (consts.DoctorID*); Label gives
+	// the translated text.
+	Name   string `json:"name"`
+	Status Status `json:"status"`
+	Detail string `json:"detail"`
+	Hint   string `json:"hint,omitempty"`
+}
+
+// checkLabels maps check identifiers to their copy; pointers follow the
+// active language.
+var checkLabels = map[string]*string{
+	consts.DoctorIDTokenSource: &consts.DoctorCheckTokenSource,
+	consts.DoctorIDCredsFile:   &consts.DoctorCheckCredsFile,
+	consts.DoctorIDCredsPerms:  &consts.DoctorCheckCredsPerms,
+	consts.DoctorIDCredsJSON:   &consts.DoctorCheckCredsJSON,
+	consts.DoctorIDTokenExpiry: &consts.DoctorCheckTokenExpiry,
+	consts.DoctorIDProxy:       &consts.DoctorCheckProxy,
+	consts.DoctorIDDNS:         &consts.DoctorCheckDNS,
+	consts.DoctorIDTLS:         &consts.DoctorCheckTLS,
+	consts.DoctorIDBeta:        &consts.DoctorCheckBeta,
+	consts.DoctorIDAPI:         &consts.DoctorCheckAPI,
+	consts.DoctorIDClock:       &consts.DoctorCheckClock,
+}
+
+// Label returns the check's name in the active language.
+func (c Check) Label() string {
+	if p, ok := checkLabels[c.Name]; ok {
+		return *p
+	}
+	return c.Name
+}
+
+// Report is the full checklist produced by Run.
+type Report struct {
+	Time   time.Time `json:"time"`
+	Checks []Check   `json:"checks"`
+}
+
+// Failed reports whether any check failed.
+func (r Report) Failed() bool {
+	for _, c := range r.Checks {
+		if c.Status == StatusFail {
+			return true
+		}
+	}
+	return false
+}
+
+// Options carries the settings the checks exercise.
+type Options struct {
+	// CredPath is the credentials file path (-creds).
+	CredPath string
+	// BetaHeader is the configured anthropic-beta value.
+	BetaHeader string
+	// Client performs the API request; its Timeout bounds network checks.
+	Client *http.Client
+}
+
+// Run walks through every known failure mode in dependency order, skipping
+// checks whose prerequisites failed.
+//
+// Parameters:
+//   - ctx: cancels network checks.
+//   - opt: credentials path, beta header, and HTTP client.
+//
+// Returns:
+//   - the checklist; it never returns early.
+func Run(ctx context.Context, opt Options) Report {
+	if opt.Client == nil {
+		opt.Client = http.DefaultClient
+	}
+	d := &runner{opt: opt}
+	d.checkToken()
+	proxied := d.checkProxy()
+	if d.checkDNS(ctx, proxied) {
+		d.checkTLS(ctx, proxied)
+	} else {
+		d.skip(consts.DoctorIDTLS, consts.TextDoctorNoDNS)
+	}
+	d.checkBeta()
+	d.checkAPI(ctx)
+	return Report{Time: time.Now(), Checks: d.checks}
+}
+
+// runner accumulates checks and the state later checks depend on.
+type runner struct {
+	opt    Options
+	checks []Check
+	// serverDate and localDate bracket the API response for clock skew.
+	serverDate time.Time
+	localDate  time.Time
+	// responded is true once the API answered with any HTTP status.
+	responded bool
+}
+
+func (d *runner) add(name string, status Status, detail, hint string) {
+	d.checks = append(d.checks, Check{Name: name, Status: status, Detail: detail, Hint: hint})
+}
+
+func (d *runner) skip(name, reason string) {
+	d.add(name, StatusSkip, fmt.Sprintf(consts.TextDoctorSkipped, reason), "")
+}
+
+// checkToken checks env/file precedence, the credentials file, its permissions,
+// JSON shape, and expiry.
+func (d *runner) checkToken() {
+	fromEnv := auth.TokenFromEnv()
+	info := auth.InspectCredentials(d.opt.CredPath)
+
+	if fromEnv {
+		d.add(consts.DoctorIDTokenSource, StatusOK, consts.TextDoctorTokenEnv, "")
+	} else {
+		d.add(consts.DoctorIDTokenSource, StatusOK, fmt.Sprintf(consts.TextDoctorTokenFileFmt, info.Path), "")
+	}
+
+	// With an env token the file is informational only, so its problems are
+	// warnings rather than failures.
+	bad := StatusFail
+	if fromEnv {
+		bad = StatusWarn
+	}
+
+	if info.ReadErr != nil {
+		if fromEnv && !info.Exists {
+			d.add(consts.DoctorIDCredsFile, StatusSkip, fmt.Sprintf(consts.TextDoctorEnvIgnoredFmt, consts.EnvTokenName, info.Path), "")
+		} else {
+			d.add(consts.DoctorIDCredsFile, bad, fmt.Sprintf(consts.TextDoctorFileMissingFmt, info.Path, info.ReadErr), consts.HintDoctorCredsPath)
+		}
+		d.skip(consts.DoctorIDCredsPerms, consts.TextDoctorNoFile)
+		d.skip(consts.DoctorIDCredsJSON, consts.TextDoctorNoFile)
+		d.checkTokenExpiry(fromEnv, info)
+		return
+	}
+	d.add(consts.DoctorIDCredsFile, StatusOK, fmt.Sprintf(consts.TextDoctorFileOK, info.Path), "")
+
+	if info.Mode&0o077 != 0 {
+		d.add(consts.DoctorIDCredsPerms, bad, fmt.Sprintf(consts.TextDoctorModeBadFmt, info.Mode), fmt.Sprintf(consts.HintDoctorChmodFmt, info.Path))
+	} else {
+		d.add(consts.DoctorIDCredsPerms, StatusOK, fmt.Sprintf(consts.TextDoctorModeOKFmt, info.Mode), "")
+	}
+
+	switch {
+	case info.ParseErr != nil:
+		d.add(consts.DoctorIDCredsJSON, bad, fmt.Sprintf(consts.TextDoctorJSONBadFmt, info.ParseErr), consts.HintDoctorCredsJSON)
+	case !info.HasToken:
+		d.add(consts.DoctorIDCredsJSON, bad, consts.TextDoctorJSONNoToken, consts.HintDoctorCredsJSON)
+	default:
+		d.add(consts.DoctorIDCredsJSON, StatusOK, consts.TextDoctorJSONOK, "")
+	}
+	d.checkTokenExpiry(fromEnv, info)
+}
+
+// checkTokenExpiry reports the expiry recorded in the credentials file.
+func (d *runner) checkTokenExpiry(fromEnv bool, info auth.CredentialsInfo) {
+	switch {
+	case fromEnv:
+		d.add(consts.DoctorIDTokenExpiry, StatusSkip, consts.TextDoctorExpiryEnv, "")
+	case info.ReadErr != nil || info.ParseErr != nil:
+		d.skip(consts.DoctorIDTokenExpiry, consts.TextDoctorNoFile)
+	case info.ExpiresAt.IsZero():
+		d.skip(consts.DoctorIDTokenExpiry, consts.TextDoctorExpiryUnknown)
+	default:
+		at := info.ExpiresAt.Local().Format(time.RFC1123)
+		left := time.Until(info.ExpiresAt)
+		switch {
+		case left <= 0:
+			d.add(consts.DoctorIDTokenExpiry, StatusFail, fmt.Sprintf(consts.TextDoctorExpiredFmt, utils.FriendlyDuration(-left), at), consts.HintDoctorTokenExpired)
+		case left < consts.TokenExpirySoon:
+			d.add(consts.DoctorIDTokenExpiry, StatusWarn, fmt.Sprintf(consts.TextDoctorExpiresFmt, utils.FriendlyDuration(left), at), consts.HintDoctorTokenExpired)
+		default:
+			d.add(consts.DoctorIDTokenExpiry, StatusOK, fmt.Sprintf(consts.TextDoctorExpiresFmt, utils.FriendlyDuration(left), at), "")
+		}
+	}
+}
+
+// proxyEnv lists the proxy variables Go's HTTP client honors.
+var proxyEnv = []string{"HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy", "NO_PROXY", "no_proxy"}
+
+// checkProxy reports which proxy, if any, requests to the API use.
+//
+// Returns:
+//   - true when requests go through a proxy, so direct DNS/TLS failures are
+//     only warnings.
+func (d *runner) checkProxy() bool {
+	var set []string
+	for _, name := range proxyEnv {
+		if v := strings.TrimSpace(os.Getenv(name)); v != "" {
+			set = append(set, name)
+		}
+	}
+	req, _ := http.NewRequest(http.MethodGet, api.URL, nil)
+	u, err := http.ProxyFromEnvironment(req)
+	switch {
+	case err != nil:
+		d.add(consts.DoctorIDProxy, StatusFail, fmt.Sprintf(consts.TextDoctorProxyBadFmt, err), consts.HintDoctorProxy)
+		return false
+	case u != nil:
+		d.add(consts.DoctorIDProxy, StatusOK, fmt.Sprintf(consts.TextDoctorProxyFmt, u.Redacted(), strings.Join(set, ", ")), "")
+		return true
+	case len(set) > 0:
+		d.add(consts.DoctorIDProxy, StatusOK, fmt.Sprintf(consts.TextDoctorProxyBypassFmt, api.Host, strings.Join(set, ", ")), "")
+	default:
+		d.add(consts.DoctorIDProxy, StatusOK, consts.TextDoctorNoProxy, "")
+	}
+	return false
+}
+
+// checkDNS resolves the API host.
+func (d *runner) checkDNS(ctx context.Context, proxied bool) bool {
+	ctx, cancel := d.timeout(ctx)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupHost(ctx, api.Host)
+	if err != nil {
+		d.add(consts.DoctorIDDNS, failOrWarn(proxied), err.Error(), consts.HintDoctorDNS)
+		return false
+	}
+	d.add(consts.DoctorIDDNS, StatusOK, fmt.Sprintf(consts.TextDoctorDNSFmt, api.Host, strings.Join(addrs, ", ")), "")
+	return true
+}
+
+// checkTLS completes a direct TLS handshake with the API host.
+func (d *runner) checkTLS(ctx context.Context, proxied bool) {
+	ctx, cancel := d.timeout(ctx)
+	defer cancel()
+	dialer := &tls.Dialer{Config: &tls.Config{ServerName: api.Host}}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(api.Host, "443"))
+	if err != nil {
+		d.add(consts.DoctorIDTLS, failOrWarn(proxied), err.Error(), consts.HintDoctorTLS)
+		return
+	}
+	defer conn.Close()
+	state := conn.(*tls.Conn).ConnectionState()
+	issuer := "unknown issuer"
+	if len(state.PeerCertificates) > 0 {
+		issuer = state.PeerCertificates[0].Issuer.CommonName
+	}
+	d.add(consts.DoctorIDTLS, StatusOK, fmt.Sprintf(consts.TextDoctorTLSFmt, tls.VersionName(state.Version), issuer), "")
+}
+
+// checkBeta reports the configured anthropic-beta value.
+func (d *runner) checkBeta() {
+	beta := strings.TrimSpace(d.opt.BetaHeader)
+	switch beta {
+	case "":
+		d.add(consts.DoctorIDBeta, StatusFail, consts.TextDoctorBetaEmpty, consts.HintDoctorBetaEmpty)
+	case consts.DefaultBetaName:
+		d.add(consts.DoctorIDBeta, StatusWarn, fmt.Sprintf(consts.TextDoctorBetaDefaultFmt, beta), consts.HintDoctorBetaDefault)
+	default:
+		d.add(consts.DoctorIDBeta, StatusOK, fmt.Sprintf(consts.TextDoctorBetaCustomFmt, beta), "")
+	}
+}
+
+// checkAPI performs one real usage request and derives clock skew from the
+// response Date header.
+func (d *runner) checkAPI(ctx context.Context) {
+	token, err := auth.ResolveToken(d.opt.CredPath)
+	if err != nil {
+		d.add(consts.DoctorIDAPI, StatusSkip, fmt.Sprintf(consts.TextDoctorSkipped, err), consts.HintDoctorTokenMissing)
+		d.skip(consts.DoctorIDClock, consts.TextDoctorNoToken)
+		return
+	}
+
+	ctx, cancel := d.timeout(ctx)
+	defer cancel()
+	data, err := api.FetchUsage(ctx, dateRecorder{d}, token, strings.TrimSpace(d.opt.BetaHeader))
+
+	var httpErr api.HTTPError
+	switch {
+	case err == nil:
+		d.add(consts.DoctorIDAPI, StatusOK, fmt.Sprintf(consts.TextDoctorAPIOKFmt, consts.TextDoctorWindows.Format(windowCount(data))), "")
+	case errors.As(err, &httpErr):
+		status, hint := StatusFail, consts.HintDoctorAPIBeta
+		switch httpErr.Status {
+		case http.StatusUnauthorized:
+			hint = consts.HintDoctorAPIAuth
+		case http.StatusTooManyRequests:
+			status, hint = StatusWarn, consts.HintDoctorAPIRate
+		}
+		d.add(consts.DoctorIDAPI, status, httpErr.Error(), hint)
+	default:
+		d.add(consts.DoctorIDAPI, StatusFail, err.Error(), consts.HintDoctorAPINetwork)
+	}
+	d.checkClock()
+}
+
+// checkClock compares the server's Date header with the local clock.
+func (d *runner) checkClock() {
+	if !d.responded {
+		d.skip(consts.DoctorIDClock, consts.TextDoctorNoResponse)
+		return
+	}
+	if d.serverDate.IsZero() {
+		d.skip(consts.DoctorIDClock, consts.TextDoctorNoDate)
+		return
+	}
+	// Date has one-second resolution, so sub-second skew is noise.
+	skew := d.localDate.Sub(d.serverDate).Round(time.Second)
+	abs := skew
+	if abs < 0 {
+		abs = -abs
+	}
+	switch {
+	case abs <= consts.ClockSkewLimit:
+		d.add(consts.DoctorIDClock, StatusOK, fmt.Sprintf(consts.TextDoctorClockOKFmt, consts.ClockSkewLimit), "")
+	case skew > 0:
+		d.add(consts.DoctorIDClock, StatusWarn, fmt.Sprintf(consts.TextDoctorClockAheadFmt, abs), consts.HintDoctorClock)
+	default:
+		d.add(consts.DoctorIDClock, StatusWarn, fmt.Sprintf(consts.TextDoctorClockBehindFmt, abs), consts.HintDoctorClock)
+	}
+}
+
+// timeout bounds a network check by the client timeout.
+func (d *runner) timeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	t := d.opt.Client.Timeout
+	if t <= 0 {
+		t = 10 * time.Second
+	}
+	return context.WithTimeout(ctx, t)
+}
+
+// dateRecorder passes requests to the configured client and records the
+// response Date header alongside the local receive time.
+type dateRecorder struct {
+	d *runner
+}
+
+func (r dateRecorder) Do(req *http.Request) (*http.Response, error) {
+	sent := time.Now()
+	resp, err := r.d.opt.Client.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	r.d.responded = true
+	// The server stamps Date somewhere between send and receive; the
+	// midpoint halves the round-trip error.
+	r.d.localDate = sent.Add(time.Since(sent) / 2)
+	if t, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		r.d.serverDate = t
+	}
+	return resp, nil
+}
+
+// failOrWarn downgrades direct-connection failures when a proxy is in use,
+// since only the proxy needs to reach the host.
+func failOrWarn(proxied bool) Status {
+	if proxied {
+		return StatusWarn
+	}
+	return StatusFail
+}
+
+// windowCount counts windows present in a response.
+func windowCount(u api.UsageResponse) int {
+	n := 0
+	for _, w := range []*api.WindowUsage{u.FiveHour, u.SevenDay} {
+		if w != nil {
+			n++
+		}
+	}
+	return n
+}
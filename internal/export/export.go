@@ -0,0 +1,183 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/report"
+	"claude-monitor/internal/store"
+)
+
+// Options controls which samples are exported and how they are encoded.
+type Options struct {
+	// Format is one of consts.FormatCSV, consts.FormatTSV or consts.FormatJSONL.
+	Format string
+	// TimeFormat is consts.TimeFormatRFC3339 or consts.TimeFormatEpoch.
+	TimeFormat string
+	// Window limits output to one window key; empty exports all windows.
+	Window string
+}
+
+// record is one window of one sample, the unit of every export format.
+type record struct {
+	Time        time.Time
+	Window      string
+	Utilization float64
+	ResetsAt    *time.Time
+}
+
+// jsonRecord fixes the JSON Lines field order to match the delimited columns.
+type jsonRecord struct {
+	Time        any     `json:"time"`
+	Window      string  `json:"window"`
+	Utilization float64 `json:"utilization"`
+	ResetsAt    any     `json:"resets_at"`
+}
+
+// columns are the header fields shared by the delimited formats.
+var columns = []string{"time", "window", "utilization", "resets_at"}
+
+// Validate checks format, time format, and window values.
+//
+// Returns:
+//   - nil when all options are recognized.
+//   - an error naming the first invalid value.
+func (o Options) Validate() error {
+	switch o.Format {
+	case consts.FormatCSV, consts.FormatTSV, consts.FormatJSONL:
+	default:
+		return fmt.Errorf(consts.ErrFormatFmt, o.Format)
+	}
+	switch o.TimeFormat {
+	case consts.TimeFormatRFC3339, consts.TimeFormatEpoch:
+	default:
+		return fmt.Errorf(consts.ErrTimeFormatFmt, o.TimeFormat)
+	}
+	if o.Window == "" {
+		return nil
+	}
+	for _, w := range report.Windows {
+		if w.Key == o.Window {
+			return nil
+		}
+	}
+	return fmt.Errorf(consts.ErrWindowFmt, o.Window)
+}
+
+// Write encodes samples to w, one record per window per sample.
+//
+// Parameters:
+//   - w: destination writer.
+//   - samples: samples to export, oldest first.
+//   - opt: format, timestamp, and window options (validated first).
+//
+// Returns:
+//   - error on invalid options or write failure.
+func Write(w io.Writer, samples []store.Sample, opt Options) error {
+	if err := opt.Validate(); err != nil {
+		return err
+	}
+	records := flatten(samples, opt.Window)
+
+	switch opt.Format {
+	case consts.FormatJSONL:
+		return writeJSONL(w, records, opt)
+	case consts.FormatTSV:
+		return writeDelimited(w, records, opt, '\t')
+	default:
+		return writeDelimited(w, records, opt, ',')
+	}
+}
+
+// flatten expands samples into per-window records, skipping windows without
+// utilization data.
+func flatten(samples []store.Sample, window string) []record {
+	records := make([]record, 0, len(samples)*len(report.Windows))
+	for _, s := range samples {
+		for _, win := range report.Windows {
+			if window != "" && win.Key != window {
+				continue
+			}
+			u := win.Pick(s.UsageResponse)
+			if u == nil || u.Utilization == nil {
+				continue
+			}
+			records = append(records, record{
+				Time:        s.Time,
+				Window:      win.Key,
+				Utilization: *u.Utilization,
+				ResetsAt:    u.ResetsAt,
+			})
+		}
+	}
+	return records
+}
+
+// writeDelimited writes a header row and one row per record.
+func writeDelimited(w io.Writer, records []record, opt Options, comma rune) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	if err := cw.Write(columns); err != nil {
+		return err
+	}
+	for _, r := range records {
+		resets := ""
+		if r.ResetsAt != nil {
+			resets = formatTime(*r.ResetsAt, opt.TimeFormat)
+		}
+		row := []string{
+			formatTime(r.Time, opt.TimeFormat),
+			r.Window,
+			strconv.FormatFloat(r.Utilization, 'f', -1, 64),
+			resets,
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeJSONL writes one JSON object per record. Epoch timestamps are emitted
+// as numbers so notebooks can parse them without conversion.
+func writeJSONL(w io.Writer, records []record, opt Options) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	for _, r := range records {
+		obj := jsonRecord{
+			Time:        jsonTime(r.Time, opt.TimeFormat),
+			Window:      r.Window,
+			Utilization: r.Utilization,
+		}
+		if r.ResetsAt != nil {
+			obj.ResetsAt = jsonTime(*r.ResetsAt, opt.TimeFormat)
+		}
+		if err := enc.Encode(obj); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// formatTime renders t as RFC3339 (UTC) or Unix seconds text.
+func formatTime(t time.Time, format string) string {
+	if format == consts.TimeFormatEpoch {
+		return strconv.FormatInt(t.Unix(), 10)
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// jsonTime returns t as a number for epoch output and a string otherwise.
+func jsonTime(t time.Time, format string) any {
+	if format == consts.TimeFormatEpoch {
+		return t.Unix()
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,374 @@
+package i18n
+
+import "claude-monitor/internal/consts"
+
+// german is the German catalog.
+var german = catalog{
+	texts: map[*string]string{
+		// Dashboard.
+		&consts.HeaderTitle:              "Claude Code Nutzung",
+		&consts.TextNoData:               "Keine Auslastungsdaten verfügbar.",
+		&consts.TextIntervalFmt:          "Intervall %s",
+		&consts.TextNextPollFmt:          "nächste Abfrage %s",
+		&consts.TextStatusFetch:          "%s lade aktuelle Daten…",
+		&consts.TextStatusWaiting:        "warte auf erste Messung…",
+		&consts.TextErrorFmt:             "Fehler: %v",
+		&consts.TextTokenErrorFmt:        "Token-Fehler: %v",
+		&consts.TextConfigErrFmt:         "Konfigurationsfehler: %v",
+		&consts.TextAppErrFmt:            "Anwendungsfehler: %v",
+		&consts.TextBetaDefaultWarning:   "Warnung: eingebauter Beta-Header wird verwendet; überschreibe -beta-header oder " + consts.EnvBetaHeader + ", wenn Anthropic die Betas wechselt",
+		&consts.TextTimeoutEnvWarningFmt: "Warnung: ungültiger Wert für %s: %q; verwende Standard %s",
+		&consts.LabelCurrent:             "Aktuell",
+		&consts.LabelWeekly:              "Wöchentlich",
+		&consts.TextPausedBadge:          "pausiert",
+
+		// Flags.
+		&consts.FlagIntervalHelp:    "Abfrageintervall (z. B. 15s, 1m)",
+		&consts.FlagCredsHelp:       "Pfad zur Credentials-JSON (verwendet ANTHROPIC_OAUTH_TOKEN, falls gesetzt)",
+		&consts.FlagTimeoutHelp:     "HTTP-Timeout (z. B. 5s, 2s)",
+		&consts.FlagBetaHelp:        "Wert des Anthropic-Beta-Headers; kommagetrennte Alternativen werden der Reihe nach versucht, wenn die API ihn ablehnt",
+		&consts.FlagBetaStateHelp:   "Datei, die sich den zuletzt funktionierenden Beta-Header merkt (leer deaktiviert)",
+		&consts.TextBetaAutoFmt:     "Beta %s (automatisch)",
+		&consts.FlagHistoryHelp:     "Pfad zum lokalen Messprotokoll (leer deaktiviert die Aufzeichnung)",
+		&consts.FlagMinIntervalHelp: "schnellstes adaptives Abfrageintervall (Standard Intervall/4)",
+		&consts.FlagMaxIntervalHelp: "langsamstes adaptives Abfrageintervall, begrenzt auch den Fehler-Backoff (Standard Intervall×8)",
+		&consts.FlagCacheHelp:       "Pfad zum letzten gültigen Snapshot, der beim Start und offline angezeigt wird (leer deaktiviert)",
+
+		// Short help.
+		&consts.HelpRefreshDesc:  "aktualisieren",
+		&consts.HelpQuitDesc:     "beenden",
+		&consts.HelpHistoryDesc:  "Verlauf",
+		&consts.HelpPauseDesc:    "Pause",
+		&consts.HelpIntervalDesc: "Intervall",
+		&consts.HelpBackDesc:     "zurück",
+		&consts.HelpRangeDesc:    "Zeitraum",
+		&consts.HelpPanDesc:      "verschieben",
+		&consts.HelpZoomDesc:     "Zoom",
+
+		// Errors.
+		&consts.ErrTokenRequired:          "Token erforderlich",
+		&consts.ErrHTTPClientRequired:     "HTTP-Client erforderlich",
+		&consts.ErrHTTPClientTimeout:      "HTTP-Client-Timeout muss positiv sein",
+		&consts.ErrRefreshInterval:        "Aktualisierungsintervall muss positiv sein",
+		&consts.ErrCacheRequired:          "-cache muss gesetzt sein, um den Snapshot aktuell zu halten",
+		&consts.ErrRefreshBounds:          "Minimal-/Maximalintervall müssen positiv sein und das Minimum darf das Maximum nicht überschreiten",
+		&consts.ErrRefreshTooSmallFmt:     "Aktualisierungsintervall zu klein; mindestens %s",
+		&consts.ErrMinRefreshTooSmallFmt:  "minimales Aktualisierungsintervall zu klein; mindestens %s",
+		&consts.ErrThresholdRange:         "Schwellenwert muss zwischen 0 und 100 liegen",
+		&consts.ErrMissingToken:           "OAuth-Token fehlt",
+		&consts.ErrBetaHeaderRequired:     "Beta-Header erforderlich",
+		&consts.ErrReadCredentialsFmt:     "Credentials lesen: %w",
+		&consts.ErrCredentialsModeFmt:     "Credentials-Datei %s darf für Gruppe/andere nicht lesbar sein (Modus %v); setze chmod 600",
+		&consts.ErrParseCredentialsFmt:    "Credentials parsen: %w",
+		&consts.ErrEmptyAccessToken:       "accessToken in der Credentials-Datei ist leer",
+		&consts.ErrParseSnapshotFmt:       "Snapshot parsen: %w",
+		&consts.ErrReadSamplesFmt:         "Messungen lesen: %w",
+		&consts.ErrSpanInvalidFmt:         "ungültige Spanne %q (z. B. 90m, 24h, 7d, 2w)",
+		&consts.ErrGroupByFmt:             "unbekannte Gruppierung %q (day, hour oder window)",
+		&consts.ErrFormatFmt:              "unbekanntes Format %q",
+		&consts.ErrTimeFormatFmt:          "unbekanntes Zeitformat %q (rfc3339 oder epoch)",
+		&consts.ErrWindowFmt:              "unbekanntes Fenster %q (five_hour oder seven_day)",
+		&consts.ErrCheckThresholdFmt:      "ungültiger Schwellenwert %q (Prozent 0–100, optional fenster=prozent)",
+		&consts.ErrCheckThresholdOrderFmt: "%s: Warnschwelle %g liegt über der kritischen Schwelle %g",
+		&consts.ErrTimeValueFmt:           "ungültige Zeit %q (RFC3339, z. B. 2025-01-02T15:04:05Z)",
+		&consts.TextRequestTimedOut:       "Zeitüberschreitung der Anfrage",
+		&consts.TextRequestCanceled:       "Anfrage abgebrochen",
+
+		// Stale data and skeleton.
+		&consts.TextStaleFmt:      "veraltet %s",
+		&consts.TextSkeletonReset: "Reset um …",
+		&consts.TextSkeletonLeft:  "... übrig",
+
+		// Durations and reset times.
+		&consts.TextSecondsFmt: "%d s",
+		&consts.TextLtMinute:   "weniger als eine Minute",
+		&consts.TextMinutesFmt: "%d Min.",
+		&consts.TextHourExact:  "%d Std.",
+		&consts.TextHourMinute: "%d Std. %d Min.",
+		&consts.TextDaysFmt:    "%d T.",
+		&consts.TextDaysHours:  "%d T. %d Std.",
+		&consts.TextResetAtFmt: "Reset %s",
+		&consts.TextResetSoon:  "Reset in Kürze",
+		&consts.TextRemainFmt:  "noch %s",
+		&consts.TextUpdatedNow: "gerade aktualisiert",
+		&consts.TextUpdatedAgo: "vor %s aktualisiert",
+
+		// Report.
+		&consts.FlagSinceHelp:           "Rückblick (z. B. 24h, 7d, 2w)",
+		&consts.FlagGroupByHelp:         "Zeiträume gruppieren nach day, hour oder window",
+		&consts.FlagReportFormatHelp:    "Ausgabeformat: text oder markdown",
+		&consts.FlagThresholdHelp:       "Auslastung in Prozent, ab der die Nutzung als hoch gilt",
+		&consts.ReportDayLayout:         "Mon 02. Jan",
+		&consts.ReportHourLayout:        "02. Jan 15:04",
+		&consts.ReportRangeLayout:       "02. Jan 15:04",
+		&consts.TextReportTitle:         "Claude Code Nutzungsbericht",
+		&consts.TextReportRangeFmt:      "%s – %s · gruppiert nach %s · Schwelle %.0f%%",
+		&consts.TextReportWindowFmt:     "bis %s",
+		&consts.TextReportUnknownWindow: "unbekanntes Fenster",
+		&consts.TextReportEmpty:         "Keine Messungen in diesem Zeitraum. Messungen werden aufgezeichnet, solange der Monitor läuft.",
+		&consts.TextReportResetsTitle:   "Resets",
+		&consts.TextReportNoResets:      "keine beobachtet",
+		&consts.TextReportResetFmt:      "%s  %s zurückgesetzt (war %.1f%%)",
+		&consts.ColPeriod:               "Zeitraum",
+		&consts.ColWindow:               "Fenster",
+		&consts.ColPeak:                 "Spitze",
+		&consts.ColLimitHits:            "Limit erreicht",
+		&consts.ColResets:               "Resets",
+		&consts.ColSamples:              "Messungen",
+
+		// History chart.
+		&consts.ChartDateLayout:      "02. Jan",
+		&consts.TextHistoryTitle:     "Verlauf",
+		&consts.TextHistoryEndingFmt: "bis %s",
+		&consts.TextHistoryLoading:   "lade Verlauf…",
+		&consts.TextHistoryEmpty:     "Noch keine Messungen; der Verlauf füllt sich, während der Monitor läuft.",
+		&consts.TextHistoryDisabled:  "Die Aufzeichnung ist deaktiviert; setze -history auf einen Dateipfad, um das Diagramm zu aktivieren.",
+		&consts.TextChartResetLegend: "Reset",
+
+		// Export.
+		&consts.FlagFromHelp:         "nur Messungen ab dieser RFC3339-Zeit",
+		&consts.FlagUntilHelp:        "nur Messungen bis zu dieser RFC3339-Zeit",
+		&consts.FlagExportSinceHelp:  "nur Messungen innerhalb dieses Rückblicks (z. B. 24h, 7d); hat Vorrang vor -from",
+		&consts.FlagWindowFilterHelp: "nur dieses Fenster: five_hour oder seven_day (Standard alle)",
+		&consts.FlagExportFormatHelp: "Ausgabeformat: csv, tsv oder jsonl",
+		&consts.FlagTimeFormatHelp:   "Zeitstempel-Kodierung: rfc3339 oder epoch",
+		&consts.FlagOutputHelp:       "in diese Datei statt auf stdout schreiben",
+
+		// Tmux, bar and check.
+		&consts.FlagRefreshHelp:        "weiter abfragen und den Snapshot aktualisieren, statt einmal auszugeben",
+		&consts.FlagMaxAgeHelp:         "Ausgabe abblenden, wenn der Snapshot älter ist (0 nie)",
+		&consts.FlagRemainingHelp:      "verbleibende Zeit bis zum Reset jedes Fensters anhängen",
+		&consts.FlagBarFormatHelp:      "Statusleisten-Format: waybar, i3blocks, i3bar oder polybar",
+		&consts.FlagWarnHelp:           "Warnschwelle in Prozent (Standard 80); eine Zahl oder fenster=wert-Paare (z. B. 80 oder five_hour=70,seven_day=85)",
+		&consts.FlagCritHelp:           "kritische Schwelle in Prozent (Standard 95); eine Zahl oder fenster=wert-Paare (z. B. 95 oder seven_day=90)",
+		&consts.FlagBelowHelp:          "fortfahren, sobald die Auslastung unter diesem Prozentwert liegt",
+		&consts.FlagWaitWindowHelp:     "Fenster, auf das gewartet wird: five_hour oder seven_day",
+		&consts.FlagNoWaitHelp:         "sofort mit Fehlercode beenden, statt zu warten",
+		&consts.TextWaitStatusFmt:      "%s bei %.1f%% (Grenze %.0f%%)",
+		&consts.TextWaitFmt:            "warte: %s; nächste Prüfung in %s",
+		&consts.TextWaitErrorFmt:       "warte: %s; neuer Versuch in %s",
+		&consts.TextWaitResetPassed:    "Fenster wurde zurückgesetzt; fahre fort",
+		&consts.TextWaitResetPassedFmt: "Fenster wurde zurückgesetzt; fahre fort (%s)",
+		&consts.TextWaitNoData:         "keine Auslastungsdaten für das Fenster; fahre fort",
+
+		// Doctor.
+		&consts.FlagDoctorFormatHelp:     "Ausgabeformat: text oder json",
+		&consts.DoctorCheckTokenSource:   "Token-Quelle",
+		&consts.DoctorCheckCredsFile:     "Credentials-Datei",
+		&consts.DoctorCheckCredsPerms:    "Credentials-Rechte",
+		&consts.DoctorCheckCredsJSON:     "Credentials-JSON",
+		&consts.DoctorCheckTokenExpiry:   "Token-Ablauf",
+		&consts.DoctorCheckProxy:         "Proxy",
+		&consts.DoctorCheckDNS:           "DNS",
+		&consts.DoctorCheckTLS:           "TLS",
+		&consts.DoctorCheckBeta:          "Beta-Header",
+		&consts.DoctorCheckAPI:           "API-Anfrage",
+		&consts.DoctorCheckClock:         "Uhrabweichung",
+		&consts.TextDoctorTokenEnv:       consts.EnvTokenName + " ist gesetzt; die Credentials-Datei wird ignoriert",
+		&consts.TextDoctorTokenFileFmt:   consts.EnvTokenName + " ist nicht gesetzt; verwende %s",
+		&consts.TextDoctorEnvIgnoredFmt:  "nicht verwendet (%s hat Vorrang): %s",
+		&consts.TextDoctorFileOK:         "%s existiert",
+		&consts.TextDoctorModeOKFmt:      "Modus %04o",
+		&consts.TextDoctorModeBadFmt:     "Modus %04o erlaubt Zugriff für Gruppe/andere",
+		&consts.TextDoctorJSONBadFmt:     "kein gültiges JSON: %v",
+		&consts.TextDoctorJSONNoToken:    "claudeAiOauth.accessToken fehlt oder ist leer",
+		&consts.TextDoctorJSONOK:         "claudeAiOauth.accessToken vorhanden",
+		&consts.TextDoctorExpiryUnknown:  "kein expiresAt hinterlegt",
+		&consts.TextDoctorExpiredFmt:     "abgelaufen vor %s (%s)",
+		&consts.TextDoctorExpiresFmt:     "läuft ab in %s (%s)",
+		&consts.TextDoctorExpiryEnv:      "unbekannt für Tokens aus " + consts.EnvTokenName,
+		&consts.TextDoctorSkipped:        "übersprungen: %s",
+		&consts.TextDoctorNoProxy:        "kein Proxy konfiguriert",
+		&consts.TextDoctorProxyFmt:       "Anfragen laufen über %s (%s)",
+		&consts.TextDoctorProxyBadFmt:    "ungültige Proxy-Einstellung: %v",
+		&consts.TextDoctorProxyBypassFmt: "%s umgeht den Proxy (%s)",
+		&consts.TextDoctorDNSFmt:         "%s wird aufgelöst zu %s",
+		&consts.TextDoctorTLSFmt:         "%s Handshake OK, Zertifikat ausgestellt von %s",
+		&consts.TextDoctorBetaEmpty:      "kein Beta-Header konfiguriert",
+		&consts.TextDoctorBetaDefaultFmt: "verwende den eingebauten Standard %q",
+		&consts.TextDoctorBetaCustomFmt:  "verwende %q",
+		&consts.TextDoctorAPIOKFmt:       "HTTP 200, %s zurückgegeben",
+		&consts.TextDoctorClockOKFmt:     "lokale Uhr weicht höchstens %s vom Server ab",
+		&consts.TextDoctorClockAheadFmt:  "lokale Uhr geht %s vor gegenüber dem Server",
+		&consts.TextDoctorClockBehindFmt: "lokale Uhr geht %s nach gegenüber dem Server",
+		&consts.TextDoctorNoDate:         "Server hat keinen Date-Header gesendet",
+		&consts.TextDoctorNoToken:        "kein Token",
+		&consts.TextDoctorNoDNS:          "DNS-Auflösung fehlgeschlagen",
+		&consts.TextDoctorNoResponse:     "keine API-Antwort",
+		&consts.TextDoctorNoFile:         "Credentials-Datei nicht lesbar",
+		&consts.TextDoctorSummaryFmt:     "%d ok, %s, %d fehlgeschlagen",
+		&consts.HintDoctorTokenMissing:   "melde dich bei Claude Code an oder exportiere " + consts.EnvTokenName,
+		&consts.HintDoctorCredsPath:      "übergib -creds mit dem richtigen Pfad oder exportiere " + consts.EnvTokenName,
+		&consts.HintDoctorCredsJSON:      "melde dich erneut bei Claude Code an, um die Datei neu zu schreiben",
+		&consts.HintDoctorTokenExpired:   "öffne Claude Code, um das Token zu erneuern, oder exportiere ein frisches " + consts.EnvTokenName,
+		&consts.HintDoctorProxy:          "korrigiere HTTPS_PROXY/HTTP_PROXY; Werte müssen URLs wie http://host:3128 sein",
+		&consts.HintDoctorDNS:            "prüfe Netzwerk und Resolver; hinter einem Proxy funktioniert DNS eventuell nur über ihn",
+		&consts.HintDoctorTLS:            "eine Firewall oder ein TLS-abfangender Proxy ist evtl. im Weg; setze SSL_CERT_FILE auf dessen CA-Bundle",
+		&consts.HintDoctorBetaEmpty:      "übergib -beta-header oder setze " + consts.EnvBetaHeader,
+		&consts.HintDoctorBetaDefault:    "wenn Anfragen mit 400/401/403 scheitern, setze einen aktuellen Wert per -beta-header oder " + consts.EnvBetaHeader,
+		&consts.HintDoctorAPIAuth:        "das Token wurde abgelehnt; erneuere es durch Öffnen von Claude Code oder prüfe den Beta-Header",
+		&consts.HintDoctorAPIBeta:        "die Anfrage wurde abgelehnt; der Beta-Header ist eventuell veraltet",
+		&consts.HintDoctorAPIRate:        "Rate-Limit erreicht; erhöhe -interval",
+		&consts.HintDoctorAPINetwork:     "prüfe Verbindung, Proxy-Einstellungen und -http-timeout",
+		&consts.HintDoctorClock:          "aktiviere NTP-Zeitsynchronisation; Reset-Zeiten und Countdowns hängen von der lokalen Uhr ab",
+
+		// Commands.
+		&consts.SummaryTUI:            "interaktives Dashboard (Standard)",
+		&consts.SummaryStatus:         "aktuelle Nutzung einmal ausgeben und beenden",
+		&consts.SummaryWatch:          "Nutzung nach jeder Abfrage ausgeben, als Logzeilen oder neu gezeichneter Block",
+		&consts.SummaryServe:          "Nutzung als JSON und Prometheus-Metriken über HTTP bereitstellen",
+		&consts.SummaryReport:         "aufgezeichnete Messungen zusammenfassen",
+		&consts.SummaryExport:         "aufgezeichnete Messungen als CSV, TSV oder JSON Lines exportieren",
+		&consts.SummaryDoctor:         "Probleme mit Credentials, Netzwerk und API diagnostizieren",
+		&consts.SummaryConfig:         "die wirksame Konfiguration ausgeben",
+		&consts.SummaryVersion:        "Versionsinformationen ausgeben",
+		&consts.SummaryCheck:          "Nagios-Check mit Exit-Codes und Perfdata",
+		&consts.SummaryWait:           "auf Kontingent warten, dann einen Befehl ausführen",
+		&consts.SummaryTmux:           "tmux-Statusausschnitt aus dem Snapshot ausgeben",
+		&consts.SummaryBar:            "Zeilen für Waybar, i3blocks, i3bar oder polybar streamen",
+		&consts.SummaryCompletion:     "Vervollständigungsskript für bash, zsh oder fish ausgeben",
+		&consts.SummaryHelp:           "Hilfe zu einem Befehl anzeigen",
+		&consts.TextUsageFmt:          "Aufruf: %s [Befehl] [Flags]",
+		&consts.TextCommandUsageFmt:   "Aufruf: %s %s [Flags] %s",
+		&consts.TextCommandsTitle:     "Befehle:",
+		&consts.TextFlagsTitle:        "Flags:",
+		&consts.TextHelpFooterFmt:     "'%s help <Befehl>' zeigt die Flags eines Befehls. Ohne Befehl startet tui.",
+		&consts.ErrUnknownCommandFmt:  "unbekannter Befehl %q (siehe '" + consts.ProgramName + " help')",
+		&consts.ErrShellFmt:           "unbekannte Shell %q (bash, zsh oder fish)",
+		&consts.ErrShellRequired:      "Shell erforderlich: bash, zsh oder fish",
+		&consts.FlagAddrHelp:          "Listen-Adresse",
+		&consts.FlagStatusFormatHelp:  "Ausgabeformat: text oder json",
+		&consts.FlagWidthHelp:         "Ausgabebreite in Spalten",
+		&consts.TextServeListeningFmt: "stelle Nutzung bereit auf http://%s (/usage, /metrics, /healthz)",
+		&consts.ConfigTokenEnv:        "Umgebung (" + consts.EnvTokenName + ")",
+		&consts.ConfigTokenFile:       "Credentials-Datei",
+		&consts.FlagJSONHelp:          "JSON statt Text ausgeben",
+		&consts.TextDirty:             "(geändert)",
+		&consts.TextBetaRemembered:    "(gemerkt)",
+		&consts.TextVersionRevision:   "Revision",
+		&consts.TextVersionCommitTime: "Commit-Zeit",
+		&consts.TextVersionGo:         "Go",
+		&consts.TextVersionBeta:       "Beta-Header",
+		&consts.TextVersionAPI:        "API",
+
+		// Demo and snapshot.
+		&consts.SummaryDemo:            "das Dashboard gegen eine geskriptete Offline-API ausführen",
+		&consts.FlagLoopHelp:           "das Skript nach dem letzten Schritt neu starten",
+		&consts.SummarySnapshot:        "das Dashboard einmal als Text, ANSI, HTML oder SVG rendern",
+		&consts.FlagSnapshotFormatHelp: "Ausgabeformat: text, ansi, html oder svg",
+		&consts.FlagAtHelp:             "zu dieser RFC3339-Zeit rendern (Standard: Abrufzeit)",
+		&consts.FlagColorHelp:          "Farbprofil: ascii, ansi, ansi256 oder truecolor (Standard: truecolor, ascii für text)",
+		&consts.FlagLightHelp:          "für einen hellen Hintergrund rendern",
+		&consts.FlagInputHelp:          "diese Mess-JSON rendern (wie von 'status -format json' ausgegeben), statt abzurufen",
+		&consts.ErrColorProfileFmt:     "unbekanntes Farbprofil %q (ascii, ansi, ansi256 oder truecolor)",
+		&consts.ErrSnapshotWidthFmt:    "Breite muss mindestens %d sein",
+		&consts.ErrSampleFileFmt:       "Messung %s lesen: %w",
+
+		// Themes and bands.
+		&consts.FlagThemeHelp:      "Theme-Name (dark, light, solarized, colorblind, high-contrast oder ein eigenes Theme) oder Pfad zu einer Theme-Datei",
+		&consts.FlagThemeDirHelp:   "Verzeichnis mit *.json-Themes für den Wechsel (leer deaktiviert)",
+		&consts.HelpThemeDesc:      "Theme",
+		&consts.TextThemeFmt:       "Theme %s",
+		&consts.ErrThemeFileFmt:    "Theme %s: %w",
+		&consts.ErrThemeMissingFmt: "Farbe %q fehlt",
+		&consts.ErrThemeColorFmt:   "ungültige Farbe für %q: %q (#rrggbb oder 0-255)",
+		&consts.ErrThemeColorShape: `Farbe muss "#rrggbb" oder {"light": ..., "dark": ...} sein`,
+		&consts.ErrThemeBorderFmt:  "unbekannter Rahmen %q (rounded, normal, thick, double, block oder hidden)",
+		&consts.ErrThemeUnknownFmt: "unbekanntes Theme %q (verfügbar: %s)",
+		&consts.FlagBandsHelp:      `Schweregrad-Bänder "warn,crit" in Prozent oder "none" (Standard: 50 und der Schwellenwert)`,
+		&consts.FlagGradientHelp:   "jede Balkenzelle nach ihrer Lage in den Bändern einfärben",
+		&consts.ErrBandsFmt:        `ungültige Bänder %q ("warn,crit" mit 0 <= warn <= crit <= 100 oder "none")`,
+
+		// Keys.
+		&consts.FlagKeysHelp:        "JSON-Datei zur Tastenbelegung, z. B. {\"history\": [\"g\"]} (leer deaktiviert)",
+		&consts.HelpOverlayDesc:     "Hilfe",
+		&consts.HelpOverlayTitle:    "Tastenkürzel",
+		&consts.HelpOverlayHint:     "? oder Esc schließt",
+		&consts.HelpRefreshLongDesc: "jetzt aktualisieren",
+		&consts.HelpHistoryLongDesc: "Verlaufsdiagramm",
+		&consts.HelpBackLongDesc:    "Diagramm oder Hilfe schließen",
+		&consts.HelpPauseLongDesc:   "Abfragen pausieren oder fortsetzen",
+		&consts.HelpFasterDesc:      "öfter abfragen",
+		&consts.HelpSlowerDesc:      "seltener abfragen",
+		&consts.HelpThemeLongDesc:   "nächstes Theme",
+		&consts.HelpOverlayLongDesc: "diese Hilfe ein-/ausblenden",
+		&consts.HelpQuitLongDesc:    "beenden",
+		&consts.HelpRangeFmt:        "letzte %s zeigen",
+		&consts.HelpZoomInDesc:      "hineinzoomen",
+		&consts.HelpZoomOutDesc:     "herauszoomen",
+		&consts.HelpPanLeftDesc:     "zurück verschieben",
+		&consts.HelpPanRightDesc:    "vor verschieben",
+		&consts.HelpLiveDesc:        "zu jetzt springen",
+		&consts.ErrKeysFileFmt:      "Tasten %s: %w",
+		&consts.ErrKeysActionFmt:    "unbekannte Aktion %q (verfügbar: %s)",
+		&consts.ErrKeysEmptyFmt:     "Aktion %q hat eine leere Taste",
+		&consts.ErrKeysConflictFmt:  "Taste %q ist sowohl %s als auch %s zugewiesen",
+		&consts.ErrKeysQuitRequired: "quit braucht mindestens eine Taste",
+
+		// Layouts and watch.
+		&consts.FlagLayoutHelp:    "Dashboard-Layout: auto, full, compact oder minimal (auto wählt nach Fensterhöhe)",
+		&consts.ErrLayoutFmt:      "unbekanntes Layout %q (auto, full, compact oder minimal)",
+		&consts.FlagWatchModeHelp: "Ausgabe: log (eine Zeile pro Abfrage) oder block (Balken im Terminal neu gezeichnet, sonst angehängt)",
+		&consts.FlagAppendHelp:    "Blöcke anhängen statt neu zeichnen, auch im Terminal",
+		&consts.ErrWatchModeFmt:   "unbekannter Watch-Modus %q (log oder block)",
+
+		// Event log.
+		&consts.HelpEventsDesc:        "Ereignisse",
+		&consts.HelpEventsLongDesc:    "Ereignisprotokoll",
+		&consts.HelpScrollDesc:        "scrollen",
+		&consts.HelpPageDesc:          "blättern",
+		&consts.HelpScrollUpDesc:      "nach oben scrollen",
+		&consts.HelpScrollDnDesc:      "nach unten scrollen",
+		&consts.HelpPageUpDesc:        "Seite hoch",
+		&consts.HelpPageDnDesc:        "Seite runter",
+		&consts.HelpTopDesc:           "älteste",
+		&consts.HelpBottomDesc:        "neueste",
+		&consts.TextEventsTitle:       "Ereignisse",
+		&consts.TextEventsCountFmt:    "%d behalten",
+		&consts.TextEventsEmpty:       "Noch keine Ereignisse.",
+		&consts.TextEventFetchFmt:     "abgerufen in %s · %s",
+		&consts.TextEventErrorFmt:     "fehlgeschlagen nach %s: %s",
+		&consts.TextEventBackoffFmt:   "neuer Versuch in %s (Fehler %d)",
+		&consts.TextEventResetFmt:     "%s Fenster zurückgesetzt (war %.0f%%)",
+		&consts.TextEventCrossUpFmt:   "%s hat %.0f%% überschritten (jetzt %.0f%%)",
+		&consts.TextEventCrossDownFmt: "%s wieder unter %.0f%% (jetzt %.0f%%)",
+
+		// Mouse and row details.
+		&consts.FlagMouseHelp:      "Maus erfassen: Zeile anklicken für Details, Fußzeile anklicken zum Aktualisieren, Mausrad wechselt Ansichten (deaktivieren, um Text zu markieren)",
+		&consts.DetailExactLabel:   "genau",
+		&consts.DetailResetsLabel:  "Reset",
+		&consts.DetailStartedLabel: "Beginn",
+		&consts.DetailPaceLabel:    "Tempo",
+		&consts.DetailChangeLabel:  "letzte Änderung",
+		&consts.DetailPaceFmt:      "%.1f× gleichmäßiges Tempo · %.0f%% erwartet beim Reset",
+		&consts.DetailPaceEarly:    "noch zu früh",
+		&consts.DetailChangeFmt:    "%+.1f%% · vor %s",
+		&consts.DetailChangeNone:   "keine in dieser Sitzung",
+
+		// Reset time format.
+		&consts.ResetTimeLayout:   "15:04 MST",
+		&consts.ResetDayLayout:    "Mon 15:04 MST",
+		&consts.ResetDateLayout:   "Mon 02. Jan 15:04 MST",
+		&consts.ResetTime12Layout: "3:04 PM MST",
+		&consts.ResetDay12Layout:  "Mon 3:04 PM MST",
+		&consts.ResetDate12Layout: "Mon 02. Jan 3:04 PM MST",
+		&consts.FlagClockHelp:     "Uhr für Reset-Zeiten: 24h oder 12h",
+		&consts.FlagTZHelp:        "IANA-Zeitzone für Reset-Zeiten, z. B. Europe/Berlin (Standard: lokal)",
+		&consts.FlagUTCHelp:       "UTC-Zeit neben jeder Reset-Zeit anzeigen",
+		&consts.FlagResetTimeHelp: "Reset-Zeiten als both, absolute (nur Uhrzeit) oder relative (nur Restzeit)",
+		&consts.ErrClockFmt:       "unbekannte Uhr %q (24h oder 12h)",
+		&consts.ErrTZFmt:          "Zeitzone %q: %w",
+		&consts.ErrResetTimeFmt:   "unbekannte Reset-Zeit %q (both, absolute oder relative)",
+
+		// Languages.
+		&consts.FlagLangHelp: "Sprache: en, de oder ja (Standard aus LC_ALL, LC_MESSAGES oder LANG)",
+		&consts.ErrLangFmt:   "unbekannte Sprache %q (en, de oder ja)",
+	},
+	plurals: map[*consts.Plural]consts.Plural{
+		&consts.TextDoctorWindows:  {One: "%d Nutzungsfenster", Other: "%d Nutzungsfenster"},
+		&consts.TextDoctorWarnings: {One: "%d Warnung", Other: "%d Warnungen"},
+	},
+	months:     [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+	weekdays:   [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	dayPeriods: [2]string{"AM", "PM"},
+}
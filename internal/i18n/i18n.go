@@ -0,0 +1,150 @@
+// Package i18n switches the user-facing copy in consts to another language.
+// English is the source text; each catalog overwrites the copy variables it
+// translates and leaves the rest in English.
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"claude-monitor/internal/consts"
+)
+
+// catalog is one language's translation of the consts copy.
+type catalog struct {
+	texts      map[*string]string
+	plurals    map[*consts.Plural]consts.Plural
+	months     [12]string
+	weekdays   [7]string
+	dayPeriods [2]string
+}
+
+// catalogs lists the shipped translations by language code; English is the
+// source and needs none.
+var catalogs = map[string]*catalog{
+	consts.LangGerman:   &german,
+	consts.LangJapanese: &japanese,
+}
+
+var (
+	mu       sync.Mutex
+	active   = consts.LangEnglish
+	original *catalog
+)
+
+// Detect returns the supported language named by LC_ALL, LC_MESSAGES, or
+// LANG, in that order of precedence, falling back to English.
+func Detect() string {
+	for _, env := range []string{consts.EnvLCAll, consts.EnvLCMessages, consts.EnvLang} {
+		v := os.Getenv(env)
+		if v == "" {
+			continue
+		}
+		if lang, ok := normalize(v); ok {
+			return lang
+		}
+		return consts.LangEnglish
+	}
+	return consts.LangEnglish
+}
+
+// Parse resolves a -lang value; empty detects from the environment.
+//
+// Parameters:
+//   - name: language code or locale such as "de", "ja_JP.UTF-8" or "en-US".
+//
+// Returns:
+//   - the supported language code.
+//   - error for unsupported languages.
+func Parse(name string) (string, error) {
+	if strings.TrimSpace(name) == "" {
+		return Detect(), nil
+	}
+	lang, ok := normalize(name)
+	if !ok {
+		return "", fmt.Errorf(consts.ErrLangFmt, name)
+	}
+	return lang, nil
+}
+
+// normalize reduces a locale such as "de_DE.UTF-8@euro" to its language
+// code and reports whether a catalog exists for it. The C and POSIX
+// locales mean English.
+func normalize(locale string) (string, bool) {
+	lang := strings.TrimSpace(locale)
+	if i := strings.IndexAny(lang, ".@"); i >= 0 {
+		lang = lang[:i]
+	}
+	if i := strings.IndexAny(lang, "_-"); i >= 0 {
+		lang = lang[:i]
+	}
+	lang = strings.ToLower(lang)
+	switch lang {
+	case consts.LangEnglish, "c", "posix":
+		return consts.LangEnglish, true
+	}
+	_, ok := catalogs[lang]
+	return lang, ok
+}
+
+// Apply switches the consts copy to lang, restoring English first so
+// languages can be switched repeatedly. Unknown languages fall back to
+// English.
+//
+// Parameters:
+//   - lang: language code as returned by Parse or Detect.
+func Apply(lang string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if original == nil {
+		original = snapshot()
+	}
+	install(original)
+	active = consts.LangEnglish
+	if c, ok := catalogs[lang]; ok {
+		install(c)
+		active = lang
+	}
+}
+
+// Active returns the language code of the applied catalog.
+func Active() string {
+	mu.Lock()
+	defer mu.Unlock()
+	return active
+}
+
+// snapshot records the English value of every variable a catalog touches.
+func snapshot() *catalog {
+	en := &catalog{
+		texts:      make(map[*string]string),
+		plurals:    make(map[*consts.Plural]consts.Plural),
+		months:     consts.ShortMonthNames,
+		weekdays:   consts.ShortWeekdayNames,
+		dayPeriods: consts.DayPeriodNames,
+	}
+	for _, c := range catalogs {
+		for p := range c.texts {
+			en.texts[p] = *p
+		}
+		for p := range c.plurals {
+			en.plurals[p] = *p
+		}
+	}
+	return en
+}
+
+// install writes c's translations into consts.
+func install(c *catalog) {
+	for p, text := range c.texts {
+		*p = text
+	}
+	for p, forms := range c.plurals {
+		*p = forms
+	}
+	consts.ShortMonthNames = c.months
+	consts.ShortWeekdayNames = c.weekdays
+	consts.DayPeriodNames = c.dayPeriods
+}
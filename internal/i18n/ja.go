@@ -0,0 +1,374 @@
+package i18n
+
+import "claude-monitor/internal/consts"
+
+// japanese is the Japanese catalog.
+var japanese = catalog{
+	texts: map[*string]string{
+		// Dashboard.
+		&consts.HeaderTitle:              "Claude Code 使用状況",
+		&consts.TextNoData:               "使用率データがありません。",
+		&consts.TextIntervalFmt:          "間隔 %s",
+		&consts.TextNextPollFmt:          "次回取得 %s",
+		&consts.TextStatusFetch:          "%s 最新データを取得中…",
+		&consts.TextStatusWaiting:        "最初のサンプルを待機中…",
+		&consts.TextErrorFmt:             "エラー: %v",
+		&consts.TextTokenErrorFmt:        "トークンエラー: %v",
+		&consts.TextConfigErrFmt:         "設定エラー: %v",
+		&consts.TextAppErrFmt:            "アプリエラー: %v",
+		&consts.TextBetaDefaultWarning:   "警告: 組み込みの beta ヘッダーを使用しています。Anthropic が beta を切り替えたら -beta-header か " + consts.EnvBetaHeader + " で上書きしてください",
+		&consts.TextTimeoutEnvWarningFmt: "警告: %s の値 %q が無効です。既定値 %s を使用します",
+		&consts.LabelCurrent:             "現在",
+		&consts.LabelWeekly:              "週間",
+		&consts.TextPausedBadge:          "一時停止",
+
+		// Flags.
+		&consts.FlagIntervalHelp:    "取得間隔 (例: 15s, 1m)",
+		&consts.FlagCredsHelp:       "認証情報 JSON のパス (ANTHROPIC_OAUTH_TOKEN が設定されていればそれを使用)",
+		&consts.FlagTimeoutHelp:     "HTTP タイムアウト (例: 5s, 2s)",
+		&consts.FlagBetaHelp:        "Anthropic beta ヘッダーの値。API に拒否された場合はカンマ区切りの代替値を順に試す",
+		&consts.FlagBetaStateHelp:   "最後に成功した beta ヘッダーを記憶するファイル (空で無効)",
+		&consts.TextBetaAutoFmt:     "beta %s (自動)",
+		&consts.FlagHistoryHelp:     "ローカルのサンプルログのパス (空で記録しない)",
+		&consts.FlagMinIntervalHelp: "適応取得の最短間隔 (既定は間隔/4)",
+		&consts.FlagMaxIntervalHelp: "適応取得の最長間隔。エラー時のバックオフ上限も兼ねる (既定は間隔×8)",
+		&consts.FlagCacheHelp:       "起動時とオフライン時に表示する最新スナップショットのパス (空で無効)",
+
+		// Short help.
+		&consts.HelpRefreshDesc:  "更新",
+		&consts.HelpQuitDesc:     "終了",
+		&consts.HelpHistoryDesc:  "履歴",
+		&consts.HelpPauseDesc:    "一時停止",
+		&consts.HelpIntervalDesc: "間隔",
+		&consts.HelpBackDesc:     "戻る",
+		&consts.HelpRangeDesc:    "期間",
+		&consts.HelpPanDesc:      "移動",
+		&consts.HelpZoomDesc:     "拡大縮小",
+
+		// Errors.
+		&consts.ErrTokenRequired:          "トークンが必要です",
+		&consts.ErrHTTPClientRequired:     "HTTP クライアントが必要です",
+		&consts.ErrHTTPClientTimeout:      "HTTP クライアントのタイムアウトは正の値である必要があります",
+		&consts.ErrRefreshInterval:        "更新間隔は正の値である必要があります",
+		&consts.ErrCacheRequired:          "スナップショットを保つには -cache の指定が必要です",
+		&consts.ErrRefreshBounds:          "最短/最長間隔は正の値で、最短は最長以下である必要があります",
+		&consts.ErrRefreshTooSmallFmt:     "更新間隔が短すぎます。%s 以上にしてください",
+		&consts.ErrMinRefreshTooSmallFmt:  "最短更新間隔が短すぎます。%s 以上にしてください",
+		&consts.ErrThresholdRange:         "しきい値は 0 から 100 の範囲で指定してください",
+		&consts.ErrMissingToken:           "OAuth トークンがありません",
+		&consts.ErrBetaHeaderRequired:     "beta ヘッダーが必要です",
+		&consts.ErrReadCredentialsFmt:     "認証情報の読み込み: %w",
+		&consts.ErrCredentialsModeFmt:     "認証情報ファイル %s はグループ/その他から読み取れないようにしてください (モード %v)。chmod 600 を実行してください",
+		&consts.ErrParseCredentialsFmt:    "認証情報の解析: %w",
+		&consts.ErrEmptyAccessToken:       "認証情報ファイルの accessToken が空です",
+		&consts.ErrParseSnapshotFmt:       "スナップショットの解析: %w",
+		&consts.ErrReadSamplesFmt:         "サンプルの読み込み: %w",
+		&consts.ErrSpanInvalidFmt:         "無効な期間 %q (例: 90m, 24h, 7d, 2w)",
+		&consts.ErrGroupByFmt:             "不明な group-by %q (day, hour, window のいずれか)",
+		&consts.ErrFormatFmt:              "不明な形式 %q",
+		&consts.ErrTimeFormatFmt:          "不明な時刻形式 %q (rfc3339 または epoch)",
+		&consts.ErrWindowFmt:              "不明なウィンドウ %q (five_hour または seven_day)",
+		&consts.ErrCheckThresholdFmt:      "無効なしきい値 %q (0–100 のパーセント、または window=percent)",
+		&consts.ErrCheckThresholdOrderFmt: "%s: 警告しきい値 %g が危険しきい値 %g を超えています",
+		&consts.ErrTimeValueFmt:           "無効な時刻 %q (RFC3339 形式、例: 2025-01-02T15:04:05Z)",
+		&consts.TextRequestTimedOut:       "リクエストがタイムアウトしました",
+		&consts.TextRequestCanceled:       "リクエストがキャンセルされました",
+
+		// Stale data and skeleton.
+		&consts.TextStaleFmt:      "古いデータ %s",
+		&consts.TextSkeletonReset: "リセット …",
+		&consts.TextSkeletonLeft:  "残り ...",
+
+		// Durations and reset times.
+		&consts.TextSecondsFmt: "%d秒",
+		&consts.TextLtMinute:   "1分未満",
+		&consts.TextMinutesFmt: "%d分",
+		&consts.TextHourExact:  "%d時間",
+		&consts.TextHourMinute: "%d時間%d分",
+		&consts.TextDaysFmt:    "%d日",
+		&consts.TextDaysHours:  "%d日%d時間",
+		&consts.TextResetAtFmt: "%s にリセット",
+		&consts.TextResetSoon:  "まもなくリセット",
+		&consts.TextRemainFmt:  "残り %s",
+		&consts.TextUpdatedNow: "たった今更新",
+		&consts.TextUpdatedAgo: "%s前に更新",
+
+		// Report.
+		&consts.FlagSinceHelp:           "遡る期間 (例: 24h, 7d, 2w)",
+		&consts.FlagGroupByHelp:         "期間のまとめ方: day, hour または window",
+		&consts.FlagReportFormatHelp:    "出力形式: text または markdown",
+		&consts.FlagThresholdHelp:       "高使用率とみなす使用率 (パーセント)",
+		&consts.ReportDayLayout:         "1月2日 (Mon)",
+		&consts.ReportHourLayout:        "1月2日 15:04",
+		&consts.ReportRangeLayout:       "1月2日 15:04",
+		&consts.TextReportTitle:         "Claude Code 使用状況レポート",
+		&consts.TextReportRangeFmt:      "%s – %s · 集計単位 %s · しきい値 %.0f%%",
+		&consts.TextReportWindowFmt:     "%s まで",
+		&consts.TextReportUnknownWindow: "不明なウィンドウ",
+		&consts.TextReportEmpty:         "この期間のサンプルはありません。サンプルはモニターの実行中に記録されます。",
+		&consts.TextReportResetsTitle:   "リセット履歴",
+		&consts.TextReportNoResets:      "なし",
+		&consts.TextReportResetFmt:      "%s  %s がリセット (直前 %.1f%%)",
+		&consts.ColPeriod:               "期間",
+		&consts.ColWindow:               "ウィンドウ",
+		&consts.ColPeak:                 "最大",
+		&consts.ColLimitHits:            "上限到達",
+		&consts.ColResets:               "リセット",
+		&consts.ColSamples:              "サンプル",
+
+		// History chart.
+		&consts.ChartDateLayout:      "1/2",
+		&consts.TextHistoryTitle:     "履歴",
+		&consts.TextHistoryEndingFmt: "%s まで",
+		&consts.TextHistoryLoading:   "履歴を読み込み中…",
+		&consts.TextHistoryEmpty:     "まだサンプルがありません。モニターの実行中に履歴が蓄積されます。",
+		&consts.TextHistoryDisabled:  "サンプルの記録が無効です。グラフを表示するには -history にファイルパスを指定してください。",
+		&consts.TextChartResetLegend: "リセット",
+
+		// Export.
+		&consts.FlagFromHelp:         "この RFC3339 時刻以降のサンプルのみ",
+		&consts.FlagUntilHelp:        "この RFC3339 時刻以前のサンプルのみ",
+		&consts.FlagExportSinceHelp:  "この期間内のサンプルのみ (例: 24h, 7d)。-from より優先",
+		&consts.FlagWindowFilterHelp: "このウィンドウのみ: five_hour または seven_day (既定はすべて)",
+		&consts.FlagExportFormatHelp: "出力形式: csv, tsv または jsonl",
+		&consts.FlagTimeFormatHelp:   "タイムスタンプ形式: rfc3339 または epoch",
+		&consts.FlagOutputHelp:       "標準出力の代わりにこのファイルへ書き込む",
+
+		// Tmux, bar and check.
+		&consts.FlagRefreshHelp:        "一度だけ出力せず、取得を続けてスナップショットを更新する",
+		&consts.FlagMaxAgeHelp:         "スナップショットがこれより古い場合は暗く表示 (0 で無効)",
+		&consts.FlagRemainingHelp:      "各ウィンドウのリセットまでの残り時間を付ける",
+		&consts.FlagBarFormatHelp:      "ステータスバー形式: waybar, i3blocks, i3bar または polybar",
+		&consts.FlagWarnHelp:           "警告する使用率 (既定 80)。数値または window=値 の組 (例: 80 や five_hour=70,seven_day=85)",
+		&consts.FlagCritHelp:           "危険とする使用率 (既定 95)。数値または window=値 の組 (例: 95 や seven_day=90)",
+		&consts.FlagBelowHelp:          "使用率がこのパーセントを下回ったら続行",
+		&consts.FlagWaitWindowHelp:     "待機対象のウィンドウ: five_hour または seven_day",
+		&consts.FlagNoWaitHelp:         "待たずにすぐ非ゼロで終了する",
+		&consts.TextWaitStatusFmt:      "%s は %.1f%% (上限 %.0f%%)",
+		&consts.TextWaitFmt:            "待機中: %s。%s 後に再確認",
+		&consts.TextWaitErrorFmt:       "待機中: %s。%s 後に再試行",
+		&consts.TextWaitResetPassed:    "ウィンドウがリセットされたため続行",
+		&consts.TextWaitResetPassedFmt: "ウィンドウがリセットされたため続行 (%s)",
+		&consts.TextWaitNoData:         "ウィンドウの使用率データがないため続行",
+
+		// Doctor.
+		&consts.FlagDoctorFormatHelp:     "出力形式: text または json",
+		&consts.DoctorCheckTokenSource:   "トークンの取得元",
+		&consts.DoctorCheckCredsFile:     "認証情報ファイル",
+		&consts.DoctorCheckCredsPerms:    "認証情報の権限",
+		&consts.DoctorCheckCredsJSON:     "認証情報 JSON",
+		&consts.DoctorCheckTokenExpiry:   "トークンの有効期限",
+		&consts.DoctorCheckProxy:         "プロキシ",
+		&consts.DoctorCheckDNS:           "DNS",
+		&consts.DoctorCheckTLS:           "TLS",
+		&consts.DoctorCheckBeta:          "beta ヘッダー",
+		&consts.DoctorCheckAPI:           "API リクエスト",
+		&consts.DoctorCheckClock:         "時刻のずれ",
+		&consts.TextDoctorTokenEnv:       consts.EnvTokenName + " が設定されているため認証情報ファイルは無視されます",
+		&consts.TextDoctorTokenFileFmt:   consts.EnvTokenName + " は未設定のため %s を使用",
+		&consts.TextDoctorEnvIgnoredFmt:  "未使用 (%s が優先): %s",
+		&consts.TextDoctorFileOK:         "%s は存在します",
+		&consts.TextDoctorModeOKFmt:      "モード %04o",
+		&consts.TextDoctorModeBadFmt:     "モード %04o はグループ/その他にアクセスを許可しています",
+		&consts.TextDoctorJSONBadFmt:     "JSON として不正: %v",
+		&consts.TextDoctorJSONNoToken:    "claudeAiOauth.accessToken がないか空です",
+		&consts.TextDoctorJSONOK:         "claudeAiOauth.accessToken あり",
+		&consts.TextDoctorExpiryUnknown:  "expiresAt が記録されていません",
+		&consts.TextDoctorExpiredFmt:     "%s前に期限切れ (%s)",
+		&consts.TextDoctorExpiresFmt:     "%s後に期限切れ (%s)",
+		&consts.TextDoctorExpiryEnv:      consts.EnvTokenName + " で渡されたトークンは不明",
+		&consts.TextDoctorSkipped:        "スキップ: %s",
+		&consts.TextDoctorNoProxy:        "プロキシ設定なし",
+		&consts.TextDoctorProxyFmt:       "リクエストは %s 経由 (%s)",
+		&consts.TextDoctorProxyBadFmt:    "無効なプロキシ設定: %v",
+		&consts.TextDoctorProxyBypassFmt: "%s はプロキシを経由しません (%s)",
+		&consts.TextDoctorDNSFmt:         "%s は %s に解決されます",
+		&consts.TextDoctorTLSFmt:         "%s ハンドシェイク成功、証明書の発行者 %s",
+		&consts.TextDoctorBetaEmpty:      "beta ヘッダーが設定されていません",
+		&consts.TextDoctorBetaDefaultFmt: "組み込みの既定値 %q を使用",
+		&consts.TextDoctorBetaCustomFmt:  "%q を使用",
+		&consts.TextDoctorAPIOKFmt:       "HTTP 200、%s を取得",
+		&consts.TextDoctorClockOKFmt:     "ローカル時刻とサーバーの差は %s 以内",
+		&consts.TextDoctorClockAheadFmt:  "ローカル時刻がサーバーより %s 進んでいます",
+		&consts.TextDoctorClockBehindFmt: "ローカル時刻がサーバーより %s 遅れています",
+		&consts.TextDoctorNoDate:         "サーバーが Date ヘッダーを返しませんでした",
+		&consts.TextDoctorNoToken:        "トークンなし",
+		&consts.TextDoctorNoDNS:          "DNS 解決に失敗",
+		&consts.TextDoctorNoResponse:     "API の応答なし",
+		&consts.TextDoctorNoFile:         "認証情報ファイルを読めません",
+		&consts.TextDoctorSummaryFmt:     "正常 %d、%s、失敗 %d",
+		&consts.HintDoctorTokenMissing:   "Claude Code でログインするか、" + consts.EnvTokenName + " を export してください",
+		&consts.HintDoctorCredsPath:      "-creds に正しいパスを渡すか、" + consts.EnvTokenName + " を export してください",
+		&consts.HintDoctorCredsJSON:      "Claude Code で再ログインしてファイルを書き直してください",
+		&consts.HintDoctorTokenExpired:   "Claude Code を開いてトークンを更新するか、新しい " + consts.EnvTokenName + " を export してください",
+		&consts.HintDoctorProxy:          "HTTPS_PROXY/HTTP_PROXY を修正してください。値は http://host:3128 のような URL である必要があります",
+		&consts.HintDoctorDNS:            "ネットワークとリゾルバーを確認してください。プロキシ環境では DNS はプロキシ経由でのみ機能する場合があります",
+		&consts.HintDoctorTLS:            "ファイアウォールや TLS を傍受するプロキシが介在している可能性があります。SSL_CERT_FILE にその CA バンドルを設定してください",
+		&consts.HintDoctorBetaEmpty:      "-beta-header を渡すか " + consts.EnvBetaHeader + " を設定してください",
+		&consts.HintDoctorBetaDefault:    "リクエストが 400/401/403 で失敗する場合は、-beta-header か " + consts.EnvBetaHeader + " で現在の値を設定してください",
+		&consts.HintDoctorAPIAuth:        "トークンが拒否されました。Claude Code を開いて更新するか、beta ヘッダーを確認してください",
+		&consts.HintDoctorAPIBeta:        "リクエストが拒否されました。beta ヘッダーが古い可能性があります",
+		&consts.HintDoctorAPIRate:        "レート制限中です。-interval を増やしてください",
+		&consts.HintDoctorAPINetwork:     "接続、プロキシ設定、-http-timeout を確認してください",
+		&consts.HintDoctorClock:          "NTP 時刻同期を有効にしてください。リセット時刻とカウントダウンはローカル時刻に依存します",
+
+		// Commands.
+		&consts.SummaryTUI:            "対話型ダッシュボード (既定)",
+		&consts.SummaryStatus:         "現在の使用状況を一度表示して終了",
+		&consts.SummaryWatch:          "取得ごとに使用状況をログ行または再描画ブロックで表示",
+		&consts.SummaryServe:          "使用状況を JSON と Prometheus メトリクスとして HTTP で提供",
+		&consts.SummaryReport:         "記録したサンプルを集計",
+		&consts.SummaryExport:         "記録したサンプルを CSV, TSV, JSON Lines で書き出す",
+		&consts.SummaryDoctor:         "認証情報、ネットワーク、API の問題を診断",
+		&consts.SummaryConfig:         "有効な設定を表示",
+		&consts.SummaryVersion:        "バージョン情報を表示",
+		&consts.SummaryCheck:          "終了コードと perfdata を返す Nagios 形式のチェック",
+		&consts.SummaryWait:           "利用枠を待ってからコマンドを実行",
+		&consts.SummaryTmux:           "スナップショットから tmux ステータス用の文字列を表示",
+		&consts.SummaryBar:            "Waybar, i3blocks, i3bar, polybar 向けの行を出力し続ける",
+		&consts.SummaryCompletion:     "bash, zsh, fish の補完スクリプトを表示",
+		&consts.SummaryHelp:           "コマンドのヘルプを表示",
+		&consts.TextUsageFmt:          "使い方: %s [コマンド] [フラグ]",
+		&consts.TextCommandUsageFmt:   "使い方: %s %s [フラグ] %s",
+		&consts.TextCommandsTitle:     "コマンド:",
+		&consts.TextFlagsTitle:        "フラグ:",
+		&consts.TextHelpFooterFmt:     "コマンドのフラグは '%s help <コマンド>' で確認できます。コマンドなしでは tui を実行します。",
+		&consts.ErrUnknownCommandFmt:  "不明なコマンド %q ('" + consts.ProgramName + " help' を参照)",
+		&consts.ErrShellFmt:           "不明なシェル %q (bash, zsh, fish のいずれか)",
+		&consts.ErrShellRequired:      "シェルを指定してください: bash, zsh, fish",
+		&consts.FlagAddrHelp:          "待ち受けアドレス",
+		&consts.FlagStatusFormatHelp:  "出力形式: text または json",
+		&consts.FlagWidthHelp:         "出力幅 (桁数)",
+		&consts.TextServeListeningFmt: "http://%s で使用状況を提供中 (/usage, /metrics, /healthz)",
+		&consts.ConfigTokenEnv:        "環境変数 (" + consts.EnvTokenName + ")",
+		&consts.ConfigTokenFile:       "認証情報ファイル",
+		&consts.FlagJSONHelp:          "テキストの代わりに JSON を出力",
+		&consts.TextDirty:             "(未コミットの変更あり)",
+		&consts.TextBetaRemembered:    "(記憶済み)",
+		&consts.TextVersionRevision:   "リビジョン",
+		&consts.TextVersionCommitTime: "コミット日時",
+		&consts.TextVersionGo:         "Go",
+		&consts.TextVersionBeta:       "ベータヘッダー",
+		&consts.TextVersionAPI:        "API",
+
+		// Demo and snapshot.
+		&consts.SummaryDemo:            "スクリプト化したオフライン API でダッシュボードを実行",
+		&consts.FlagLoopHelp:           "最後のステップの後にスクリプトを最初から繰り返す",
+		&consts.SummarySnapshot:        "ダッシュボードをテキスト、ANSI、HTML、SVG として一度だけ描画",
+		&consts.FlagSnapshotFormatHelp: "出力形式: text, ansi, html または svg",
+		&consts.FlagAtHelp:             "この RFC3339 時刻の時点として描画 (既定: 取得時刻)",
+		&consts.FlagColorHelp:          "カラープロファイル: ascii, ansi, ansi256 または truecolor (既定: truecolor、text では ascii)",
+		&consts.FlagLightHelp:          "明るい背景向けに描画",
+		&consts.FlagInputHelp:          "取得せずにこのサンプル JSON ('status -format json' の出力) を描画",
+		&consts.ErrColorProfileFmt:     "不明なカラープロファイル %q (ascii, ansi, ansi256, truecolor のいずれか)",
+		&consts.ErrSnapshotWidthFmt:    "幅は %d 以上である必要があります",
+		&consts.ErrSampleFileFmt:       "サンプル %s の読み込み: %w",
+
+		// Themes and bands.
+		&consts.FlagThemeHelp:      "テーマ名 (dark, light, solarized, colorblind, high-contrast またはユーザーテーマ) またはテーマファイルのパス",
+		&consts.FlagThemeDirHelp:   "切り替えに加える *.json テーマファイルのディレクトリ (空で無効)",
+		&consts.HelpThemeDesc:      "テーマ",
+		&consts.TextThemeFmt:       "テーマ %s",
+		&consts.ErrThemeFileFmt:    "テーマ %s: %w",
+		&consts.ErrThemeMissingFmt: "色 %q がありません",
+		&consts.ErrThemeColorFmt:   "%q の色が無効です: %q (#rrggbb または 0-255)",
+		&consts.ErrThemeColorShape: `色は "#rrggbb" または {"light": ..., "dark": ...} で指定してください`,
+		&consts.ErrThemeBorderFmt:  "不明な枠線 %q (rounded, normal, thick, double, block, hidden のいずれか)",
+		&consts.ErrThemeUnknownFmt: "不明なテーマ %q (利用可能: %s)",
+		&consts.FlagBandsHelp:      `重要度の帯 "warn,crit" (パーセント) または "none" (既定: 50 としきい値)`,
+		&consts.FlagGradientHelp:   "バーの各セルを帯の中での位置に応じて色付け",
+		&consts.ErrBandsFmt:        `無効な帯 %q (0 <= warn <= crit <= 100 の "warn,crit" または "none")`,
+
+		// Keys.
+		&consts.FlagKeysHelp:        "キー割り当てを変更する JSON ファイル。例: {\"history\": [\"g\"]} (空で無効)",
+		&consts.HelpOverlayDesc:     "ヘルプ",
+		&consts.HelpOverlayTitle:    "キーボードショートカット",
+		&consts.HelpOverlayHint:     "? または esc で閉じる",
+		&consts.HelpRefreshLongDesc: "今すぐ更新",
+		&consts.HelpHistoryLongDesc: "履歴グラフ",
+		&consts.HelpBackLongDesc:    "グラフやヘルプを閉じる",
+		&consts.HelpPauseLongDesc:   "取得の一時停止/再開",
+		&consts.HelpFasterDesc:      "取得間隔を短く",
+		&consts.HelpSlowerDesc:      "取得間隔を長く",
+		&consts.HelpThemeLongDesc:   "次のテーマ",
+		&consts.HelpOverlayLongDesc: "このヘルプの表示切り替え",
+		&consts.HelpQuitLongDesc:    "終了",
+		&consts.HelpRangeFmt:        "直近 %s を表示",
+		&consts.HelpZoomInDesc:      "拡大",
+		&consts.HelpZoomOutDesc:     "縮小",
+		&consts.HelpPanLeftDesc:     "過去へ移動",
+		&consts.HelpPanRightDesc:    "未来へ移動",
+		&consts.HelpLiveDesc:        "現在へ移動",
+		&consts.ErrKeysFileFmt:      "キー %s: %w",
+		&consts.ErrKeysActionFmt:    "不明なアクション %q (利用可能: %s)",
+		&consts.ErrKeysEmptyFmt:     "アクション %q に空のキーがあります",
+		&consts.ErrKeysConflictFmt:  "キー %q が %s と %s の両方に割り当てられています",
+		&consts.ErrKeysQuitRequired: "quit には少なくとも 1 つのキーが必要です",
+
+		// Layouts and watch.
+		&consts.FlagLayoutHelp:    "ダッシュボードのレイアウト: auto, full, compact または minimal (auto はウィンドウの高さで選択)",
+		&consts.ErrLayoutFmt:      "不明なレイアウト %q (auto, full, compact, minimal のいずれか)",
+		&consts.FlagWatchModeHelp: "出力: log (取得ごとに 1 行) または block (端末ではバーをその場で再描画、それ以外は追記)",
+		&consts.FlagAppendHelp:    "端末でも再描画せずブロックを追記する",
+		&consts.ErrWatchModeFmt:   "不明な watch モード %q (log または block)",
+
+		// Event log.
+		&consts.HelpEventsDesc:        "イベント",
+		&consts.HelpEventsLongDesc:    "イベントログ",
+		&consts.HelpScrollDesc:        "スクロール",
+		&consts.HelpPageDesc:          "ページ",
+		&consts.HelpScrollUpDesc:      "上へスクロール",
+		&consts.HelpScrollDnDesc:      "下へスクロール",
+		&consts.HelpPageUpDesc:        "前のページ",
+		&consts.HelpPageDnDesc:        "次のページ",
+		&consts.HelpTopDesc:           "最古",
+		&consts.HelpBottomDesc:        "最新",
+		&consts.TextEventsTitle:       "イベント",
+		&consts.TextEventsCountFmt:    "%d 件保持",
+		&consts.TextEventsEmpty:       "イベントはまだありません。",
+		&consts.TextEventFetchFmt:     "%s で取得 · %s",
+		&consts.TextEventErrorFmt:     "%s 後に失敗: %s",
+		&consts.TextEventBackoffFmt:   "%s 後に再試行 (失敗 %d 回目)",
+		&consts.TextEventResetFmt:     "%s ウィンドウがリセット (直前 %.0f%%)",
+		&consts.TextEventCrossUpFmt:   "%s が %.0f%% を超過 (現在 %.0f%%)",
+		&consts.TextEventCrossDownFmt: "%s が %.0f%% 未満に回復 (現在 %.0f%%)",
+
+		// Mouse and row details.
+		&consts.FlagMouseHelp:      "マウスを使用: 行をクリックで詳細、フッターをクリックで更新、ホイールで画面切り替え (テキストを選択するには無効化)",
+		&consts.DetailExactLabel:   "正確な値",
+		&consts.DetailResetsLabel:  "リセット",
+		&consts.DetailStartedLabel: "開始",
+		&consts.DetailPaceLabel:    "ペース",
+		&consts.DetailChangeLabel:  "最終変化",
+		&consts.DetailPaceFmt:      "均等ペースの %.1f× · リセット時 %.0f%% の見込み",
+		&consts.DetailPaceEarly:    "判断するには早すぎます",
+		&consts.DetailChangeFmt:    "%+.1f%% · %s前",
+		&consts.DetailChangeNone:   "このセッションではなし",
+
+		// Reset time format.
+		&consts.ResetTimeLayout:   "15:04 MST",
+		&consts.ResetDayLayout:    "(Mon) 15:04 MST",
+		&consts.ResetDateLayout:   "1月2日(Mon) 15:04 MST",
+		&consts.ResetTime12Layout: "PM3:04 MST",
+		&consts.ResetDay12Layout:  "(Mon) PM3:04 MST",
+		&consts.ResetDate12Layout: "1月2日(Mon) PM3:04 MST",
+		&consts.FlagClockHelp:     "リセット時刻の時計: 24h または 12h",
+		&consts.FlagTZHelp:        "リセット時刻の IANA タイムゾーン。例: Asia/Tokyo (既定: ローカル)",
+		&consts.FlagUTCHelp:       "各リセット時刻の横に UTC 時刻を表示",
+		&consts.FlagResetTimeHelp: "リセット時刻の表示: both, absolute (時刻のみ) または relative (残り時間のみ)",
+		&consts.ErrClockFmt:       "不明な時計 %q (24h または 12h)",
+		&consts.ErrTZFmt:          "タイムゾーン %q: %w",
+		&consts.ErrResetTimeFmt:   "不明なリセット時刻表示 %q (both, absolute, relative のいずれか)",
+
+		// Languages.
+		&consts.FlagLangHelp: "言語: en, de または ja (既定は LC_ALL, LC_MESSAGES, LANG から判定)",
+		&consts.ErrLangFmt:   "不明な言語 %q (en, de, ja のいずれか)",
+	},
+	plurals: map[*consts.Plural]consts.Plural{
+		&consts.TextDoctorWindows:  {One: "%d 件の使用ウィンドウ", Other: "%d 件の使用ウィンドウ"},
+		&consts.TextDoctorWarnings: {One: "警告 %d", Other: "警告 %d"},
+	},
+	months:     [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	weekdays:   [7]string{"日", "月", "火", "水", "木", "金", "土"},
+	dayPeriods: [2]string{"午前", "午後"},
+}
@@ -0,0 +1,258 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"claude-monitor/internal/api"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/store"
+	"claude-monitor/internal/utils"
+)
+
+// GroupBy selects how samples are bucketed into report periods.
+type GroupBy string
+
+const (
+	// GroupDay buckets samples by local calendar day.
+	GroupDay GroupBy = "day"
+	// GroupHour buckets samples by local clock hour.
+	GroupHour GroupBy = "hour"
+	// GroupWindow buckets samples by the usage window they belong to, keyed
+	// on the window's reset time.
+	GroupWindow GroupBy = "window"
+)
+
+// maxGap caps how much time a single sample is credited with when measuring
+// time above the threshold, so gaps in recording (laptop asleep, monitor
+// closed) are not counted as sustained high usage.
+const maxGap = 10 * time.Minute
+
+// resetJitter tolerates small movements of resets_at between polls that do
+// not represent a new window.
+const resetJitter = time.Minute
+
+// Window identifies one of the rolling usage windows.
+type Window struct {
+	// Key is the API field name, e.g. "five_hour".
+	Key string
+	// label points at the user-facing name so it follows the language.
+	label *string
+	pick  func(api.UsageResponse) *api.WindowUsage
+}
+
+// Windows lists the usage windows covered by reports, in display order.
+var Windows = []Window{
+	{Key: consts.WindowFiveHour, label: &consts.LabelCurrent, pick: func(u api.UsageResponse) *api.WindowUsage { return u.FiveHour }},
+	{Key: consts.WindowSevenDay, label: &consts.LabelWeekly, pick: func(u api.UsageResponse) *api.WindowUsage { return u.SevenDay }},
+}
+
+// Label returns the user-facing name in the active language.
+func (w Window) Label() string {
+	return *w.label
+}
+
+// Pick returns the window's usage from u, or nil when absent.
+func (w Window) Pick(u api.UsageResponse) *api.WindowUsage {
+	return w.pick(u)
+}
+
+// Options controls how a report is aggregated.
+type Options struct {
+	// GroupBy selects the bucketing of periods.
+	GroupBy GroupBy
+	// Threshold is the utilization percentage counted as "high".
+	Threshold float64
+	// Location is used for day/hour boundaries; nil means time.Local.
+	Location *time.Location
+}
+
+// Row summarizes one window within one period.
+type Row struct {
+	// Period is the human-readable bucket label.
+	Period string
+	// Start is the first sample time in the bucket, used for ordering.
+	Start time.Time
+	// Window is the usage window this row describes.
+	Window Window
+	// Peak is the highest utilization seen, in percent.
+	Peak float64
+	// AboveThreshold is the time spent at or above Options.Threshold.
+	AboveThreshold time.Duration
+	// LimitHits counts transitions into 100% utilization.
+	LimitHits int
+	// Resets counts window rollovers observed in the period.
+	Resets int
+	// Samples is the number of samples with data for this window.
+	Samples int
+}
+
+// Report is the aggregated result for a range of samples.
+type Report struct {
+	// From and To bound the samples included.
+	From, To time.Time
+	// Options used to build the report.
+	Options Options
+	// Rows ordered by period then window.
+	Rows []Row
+	// ResetEvents lists observed window rollovers in time order.
+	ResetEvents []ResetEvent
+}
+
+// ResetEvent records a window rolling over between two samples.
+type ResetEvent struct {
+	// Window that reset.
+	Window Window
+	// At is the boundary the API reported before the rollover, or the first
+	// sample after it when the window rolled over early.
+	At time.Time
+	// PeakBefore is the utilization of the last sample before the reset.
+	PeakBefore float64
+}
+
+// ParseGroupBy validates a group-by flag value.
+//
+// Parameters:
+//   - s: flag text.
+//
+// Returns:
+//   - the matching GroupBy.
+//   - error for unknown values.
+func ParseGroupBy(s string) (GroupBy, error) {
+	switch g := GroupBy(s); g {
+	case GroupDay, GroupHour, GroupWindow:
+		return g, nil
+	}
+	return "", fmt.Errorf(consts.ErrGroupByFmt, s)
+}
+
+// Build aggregates samples (oldest first) into a report.
+//
+// Parameters:
+//   - samples: recorded samples sorted by time.
+//   - opt: grouping and threshold options.
+//
+// Returns:
+//   - aggregated report; Rows is empty when no sample carries data.
+func Build(samples []store.Sample, opt Options) Report {
+	if opt.Location == nil {
+		opt.Location = time.Local
+	}
+	rep := Report{Options: opt}
+	if len(samples) > 0 {
+		rep.From = samples[0].Time
+		rep.To = samples[len(samples)-1].Time
+	}
+
+	type bucketKey struct {
+		period string
+		window string
+	}
+	buckets := map[bucketKey]*Row{}
+
+	for _, win := range Windows {
+		var prev *store.Sample
+		var prevUsage *api.WindowUsage
+		for i := range samples {
+			s := &samples[i]
+			cur := win.Pick(s.UsageResponse)
+			if cur == nil || cur.Utilization == nil {
+				continue
+			}
+			util := *cur.Utilization
+
+			period := periodLabel(s.Time, cur, opt)
+			key := bucketKey{period: period, window: win.Key}
+			row, ok := buckets[key]
+			if !ok {
+				row = &Row{Period: period, Start: s.Time, Window: win}
+				buckets[key] = row
+			}
+			row.Samples++
+			if util > row.Peak {
+				row.Peak = util
+			}
+
+			if prev != nil {
+				prevUtil := *prevUsage.Utilization
+				gap := s.Time.Sub(prev.Time)
+				if gap > maxGap {
+					gap = maxGap
+				}
+				if prevUtil >= opt.Threshold && gap > 0 {
+					row.AboveThreshold += gap
+				}
+				if util >= 100 && prevUtil < 100 {
+					row.LimitHits++
+				}
+				if at, ok := detectReset(prevUsage, s.Time, cur); ok {
+					row.Resets++
+					rep.ResetEvents = append(rep.ResetEvents, ResetEvent{Window: win, At: at, PeakBefore: prevUtil})
+				}
+			} else if util >= 100 {
+				row.LimitHits++
+			}
+
+			prev, prevUsage = s, cur
+		}
+	}
+
+	rep.Rows = make([]Row, 0, len(buckets))
+	for _, row := range buckets {
+		rep.Rows = append(rep.Rows, *row)
+	}
+	order := map[string]int{}
+	for i, w := range Windows {
+		order[w.Key] = i
+	}
+	sort.SliceStable(rep.Rows, func(i, j int) bool {
+		a, b := rep.Rows[i], rep.Rows[j]
+		if opt.GroupBy == GroupWindow && a.Window.Key != b.Window.Key {
+			return order[a.Window.Key] < order[b.Window.Key]
+		}
+		if a.Period != b.Period {
+			return a.Start.Before(b.Start)
+		}
+		return order[a.Window.Key] < order[b.Window.Key]
+	})
+	sort.SliceStable(rep.ResetEvents, func(i, j int) bool { return rep.ResetEvents[i].At.Before(rep.ResetEvents[j].At) })
+	return rep
+}
+
+// periodLabel returns the bucket label for a sample.
+func periodLabel(t time.Time, win *api.WindowUsage, opt Options) string {
+	local := t.In(opt.Location)
+	switch opt.GroupBy {
+	case GroupHour:
+		hour := time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), 0, 0, 0, opt.Location)
+		return utils.FormatTime(hour, consts.ReportHourLayout)
+	case GroupWindow:
+		if win.ResetsAt == nil {
+			return consts.TextReportUnknownWindow
+		}
+		return fmt.Sprintf(consts.TextReportWindowFmt, utils.FormatTime(win.ResetsAt.In(opt.Location).Round(time.Minute), consts.ReportHourLayout))
+	default:
+		return utils.FormatTime(local, consts.ReportDayLayout)
+	}
+}
+
+// detectReset reports whether the window rolled over between two samples:
+// either the previous reset boundary has passed or the API now reports a
+// later boundary than before.
+func detectReset(prev *api.WindowUsage, curAt time.Time, cur *api.WindowUsage) (time.Time, bool) {
+	if prev.ResetsAt == nil {
+		return time.Time{}, false
+	}
+	moved := cur.ResetsAt == nil || cur.ResetsAt.Sub(*prev.ResetsAt) > resetJitter
+	if !moved {
+		return time.Time{}, false
+	}
+	if !curAt.Before(*prev.ResetsAt) {
+		return *prev.ResetsAt, true
+	}
+	if cur.ResetsAt != nil {
+		return curAt, true
+	}
+	return time.Time{}, false
+}
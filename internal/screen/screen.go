@@ -0,0 +1,434 @@
+package screen
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// Attr is the SGR state shared by a run of cells. Colors are "#rrggbb" or
+// empty for the terminal default.
+type Attr struct {
+	FG        string
+	BG        string
+	Bold      bool
+	Faint     bool
+	Italic    bool
+	Underline bool
+	Reverse   bool
+	Strike    bool
+}
+
+// Run is a stretch of text on one line drawn with the same attributes.
+type Run struct {
+	// Col is the zero-based cell column where the run starts.
+	Col int
+	// Width is the number of cells the run occupies.
+	Width int
+	Text  string
+	Attr  Attr
+}
+
+// Line is the runs of one terminal row, left to right.
+type Line []Run
+
+// Screen is a parsed frame of ANSI-styled text.
+type Screen struct {
+	Lines []Line
+	// Width is the widest line in cells.
+	Width int
+}
+
+// Theme provides the default colors used where the frame leaves them unset.
+type Theme struct {
+	FG string
+	BG string
+}
+
+var (
+	// Dark approximates a dark terminal.
+	Dark = Theme{FG: "#d0d0d0", BG: "#1c1c1c"}
+	// Light approximates a light terminal.
+	Light = Theme{FG: "#1c1c1c", BG: "#ffffff"}
+)
+
+// Geometry of one cell in SVG output, in pixels.
+const (
+	svgFontSize   = 14
+	svgCellWidth  = 8.4
+	svgLineHeight = 18
+	svgPadding    = 12
+)
+
+// Parse splits ANSI-styled text into lines of styled runs. Only SGR
+// sequences are interpreted; other escape sequences are dropped.
+//
+// Parameters:
+//   - s: text as written to a terminal.
+//
+// Returns:
+//   - the parsed screen.
+func Parse(s string) Screen {
+	var scr Screen
+	for _, raw := range strings.Split(strings.TrimSuffix(s, "\n"), "\n") {
+		line, width := parseLine(raw)
+		scr.Lines = append(scr.Lines, line)
+		if width > scr.Width {
+			scr.Width = width
+		}
+	}
+	return scr
+}
+
+// parseLine parses one row, returning its runs and width in cells. SGR state
+// is reset at every line so each row stands alone.
+func parseLine(raw string) (Line, int) {
+	var (
+		line Line
+		attr Attr
+		text strings.Builder
+		col  int
+		used int
+	)
+	flush := func() {
+		if text.Len() == 0 {
+			return
+		}
+		line = append(line, Run{Col: col, Width: used - col, Text: text.String(), Attr: attr})
+		text.Reset()
+		col = used
+	}
+	for i := 0; i < len(raw); {
+		if raw[i] == 0x1b {
+			end, params, final := scanEscape(raw, i)
+			if final == 'm' {
+				flush()
+				attr = applySGR(attr, params)
+			}
+			i = end
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(raw[i:])
+		i += size
+		if r == '\r' {
+			continue
+		}
+		text.WriteRune(r)
+		used += runewidth.RuneWidth(r)
+	}
+	flush()
+	return line, used
+}
+
+// scanEscape finds the end of the escape sequence starting at s[i].
+//
+// Returns:
+//   - the index just past the sequence.
+//   - the parameter bytes of a CSI sequence.
+//   - the CSI final byte, or 0 for other sequences.
+func scanEscape(s string, i int) (int, string, byte) {
+	if i+1 >= len(s) {
+		return len(s), "", 0
+	}
+	switch s[i+1] {
+	case '[':
+		for j := i + 2; j < len(s); j++ {
+			if c := s[j]; c >= 0x40 && c <= 0x7e {
+				return j + 1, s[i+2 : j], c
+			}
+		}
+		return len(s), "", 0
+	case ']':
+		// OSC runs until BEL or ST.
+		for j := i + 2; j < len(s); j++ {
+			if s[j] == 0x07 {
+				return j + 1, "", 0
+			}
+			if s[j] == 0x1b && j+1 < len(s) && s[j+1] == '\\' {
+				return j + 2, "", 0
+			}
+		}
+		return len(s), "", 0
+	}
+	return i + 2, "", 0
+}
+
+// applySGR updates attr with the semicolon-separated SGR parameters.
+func applySGR(attr Attr, params string) Attr {
+	if params == "" {
+		return Attr{}
+	}
+	codes := strings.Split(params, ";")
+	for i := 0; i < len(codes); i++ {
+		n, err := strconv.Atoi(codes[i])
+		if err != nil {
+			continue
+		}
+		switch {
+		case n == 0:
+			attr = Attr{}
+		case n == 1:
+			attr.Bold = true
+		case n == 2:
+			attr.Faint = true
+		case n == 3:
+			attr.Italic = true
+		case n == 4:
+			attr.Underline = true
+		case n == 7:
+			attr.Reverse = true
+		case n == 9:
+			attr.Strike = true
+		case n == 22:
+			attr.Bold, attr.Faint = false, false
+		case n == 23:
+			attr.Italic = false
+		case n == 24:
+			attr.Underline = false
+		case n == 27:
+			attr.Reverse = false
+		case n == 29:
+			attr.Strike = false
+		case n >= 30 && n <= 37:
+			attr.FG = palette(n - 30)
+		case n >= 90 && n <= 97:
+			attr.FG = palette(n - 90 + 8)
+		case n >= 40 && n <= 47:
+			attr.BG = palette(n - 40)
+		case n >= 100 && n <= 107:
+			attr.BG = palette(n - 100 + 8)
+		case n == 39:
+			attr.FG = ""
+		case n == 49:
+			attr.BG = ""
+		case n == 38 || n == 48:
+			color, skip := extendedColor(codes[i+1:])
+			i += skip
+			if n == 38 {
+				attr.FG = color
+			} else {
+				attr.BG = color
+			}
+		}
+	}
+	return attr
+}
+
+// extendedColor decodes the arguments of SGR 38/48: "5;n" or "2;r;g;b".
+//
+// Returns:
+//   - the color, or empty when malformed.
+//   - how many parameters were consumed.
+func extendedColor(args []string) (string, int) {
+	num := func(k int) int {
+		if k >= len(args) {
+			return -1
+		}
+		v, err := strconv.Atoi(args[k])
+		if err != nil || v < 0 || v > 255 {
+			return -1
+		}
+		return v
+	}
+	switch num(0) {
+	case 5:
+		if v := num(1); v >= 0 {
+			return palette(v), 2
+		}
+		return "", len(args)
+	case 2:
+		r, g, b := num(1), num(2), num(3)
+		if r < 0 || g < 0 || b < 0 {
+			return "", len(args)
+		}
+		return fmt.Sprintf("#%02x%02x%02x", r, g, b), 4
+	}
+	return "", len(args)
+}
+
+// basic16 is the xterm palette for the first 16 colors.
+var basic16 = [16]string{
+	"#000000", "#cd0000", "#00cd00", "#cdcd00", "#0000ee", "#cd00cd", "#00cdcd", "#e5e5e5",
+	"#7f7f7f", "#ff0000", "#00ff00", "#ffff00", "#5c5cff", "#ff00ff", "#00ffff", "#ffffff",
+}
+
+// palette returns the xterm RGB value of a 256-color index.
+func palette(n int) string {
+	switch {
+	case n < 16:
+		return basic16[n]
+	case n < 232:
+		n -= 16
+		level := func(v int) int {
+			if v == 0 {
+				return 0
+			}
+			return 55 + v*40
+		}
+		return fmt.Sprintf("#%02x%02x%02x", level(n/36), level(n/6%6), level(n%6))
+	default:
+		v := 8 + (n-232)*10
+		return fmt.Sprintf("#%02x%02x%02x", v, v, v)
+	}
+}
+
+// colors resolves the effective foreground and background of a run,
+// applying reverse video and theme defaults.
+func (a Attr) colors(t Theme) (string, string) {
+	fg, bg := a.FG, a.BG
+	if fg == "" {
+		fg = t.FG
+	}
+	if bg == "" {
+		bg = t.BG
+	}
+	if a.Reverse {
+		fg, bg = bg, fg
+	}
+	return fg, bg
+}
+
+// Text returns the frame without styling, with trailing blanks trimmed from
+// every line.
+func (s Screen) Text() string {
+	var b strings.Builder
+	for _, line := range s.Lines {
+		var row strings.Builder
+		for _, r := range line {
+			row.WriteString(r.Text)
+		}
+		b.WriteString(strings.TrimRight(row.String(), " "))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// HTML renders the frame as a self-contained <pre> block with inline styles,
+// suitable for pasting into wiki pages.
+//
+// Parameters:
+//   - t: default colors for unstyled cells.
+//
+// Returns:
+//   - HTML fragment ending in a newline.
+func (s Screen) HTML(t Theme) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, `<pre style="background:%s;color:%s;font-family:ui-monospace,Menlo,Consolas,monospace;line-height:1.2;padding:1em;display:inline-block">`, t.BG, t.FG)
+	for i, line := range s.Lines {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		for _, r := range line {
+			css := r.Attr.css(t)
+			if css == "" {
+				b.WriteString(html.EscapeString(r.Text))
+				continue
+			}
+			fmt.Fprintf(&b, `<span style="%s">%s</span>`, css, html.EscapeString(r.Text))
+		}
+	}
+	b.WriteString("</pre>\n")
+	return b.String()
+}
+
+// css returns the inline style for a run, or empty when it only uses the
+// theme defaults.
+func (a Attr) css(t Theme) string {
+	var parts []string
+	fg, bg := a.colors(t)
+	if fg != t.FG {
+		parts = append(parts, "color:"+fg)
+	}
+	if bg != t.BG {
+		parts = append(parts, "background:"+bg)
+	}
+	if a.Bold {
+		parts = append(parts, "font-weight:bold")
+	}
+	if a.Faint {
+		parts = append(parts, "opacity:0.6")
+	}
+	if a.Italic {
+		parts = append(parts, "font-style:italic")
+	}
+	if deco := a.decoration(); deco != "" {
+		parts = append(parts, "text-decoration:"+deco)
+	}
+	return strings.Join(parts, ";")
+}
+
+// decoration returns the CSS/SVG text-decoration value, or empty.
+func (a Attr) decoration() string {
+	var d []string
+	if a.Underline {
+		d = append(d, "underline")
+	}
+	if a.Strike {
+		d = append(d, "line-through")
+	}
+	return strings.Join(d, " ")
+}
+
+// SVG renders the frame as a standalone SVG image. Every run is pinned to its
+// cell grid position so the layout holds regardless of the viewer's
+// monospace font.
+//
+// Parameters:
+//   - t: default colors for unstyled cells.
+//
+// Returns:
+//   - SVG document ending in a newline.
+func (s Screen) SVG(t Theme) string {
+	w := float64(s.Width)*svgCellWidth + 2*svgPadding
+	h := len(s.Lines)*svgLineHeight + 2*svgPadding
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%d" viewBox="0 0 %s %d">`+"\n", fmtPx(w), h, fmtPx(w), h)
+	fmt.Fprintf(&b, `<rect width="100%%" height="100%%" fill="%s"/>`+"\n", t.BG)
+	fmt.Fprintf(&b, `<g font-family="ui-monospace,Menlo,Consolas,monospace" font-size="%d" xml:space="preserve">`+"\n", svgFontSize)
+	for i, line := range s.Lines {
+		top := svgPadding + i*svgLineHeight
+		for _, r := range line {
+			x := svgPadding + float64(r.Col)*svgCellWidth
+			width := float64(r.Width) * svgCellWidth
+			fg, bg := r.Attr.colors(t)
+			if bg != t.BG {
+				fmt.Fprintf(&b, `<rect x="%s" y="%d" width="%s" height="%d" fill="%s"/>`+"\n", fmtPx(x), top, fmtPx(width), svgLineHeight, bg)
+			}
+			if strings.TrimSpace(r.Text) == "" {
+				continue
+			}
+			fmt.Fprintf(&b, `<text x="%s" y="%d" textLength="%s" lengthAdjust="spacingAndGlyphs" fill="%s"%s>%s</text>`+"\n",
+				fmtPx(x), top+svgLineHeight-4, fmtPx(width), fg, r.Attr.svgAttrs(), html.EscapeString(r.Text))
+		}
+	}
+	b.WriteString("</g>\n</svg>\n")
+	return b.String()
+}
+
+// svgAttrs returns extra presentation attributes for a run's text.
+func (a Attr) svgAttrs() string {
+	var b strings.Builder
+	if a.Bold {
+		b.WriteString(` font-weight="bold"`)
+	}
+	if a.Faint {
+		b.WriteString(` fill-opacity="0.6"`)
+	}
+	if a.Italic {
+		b.WriteString(` font-style="italic"`)
+	}
+	if deco := a.decoration(); deco != "" {
+		fmt.Fprintf(&b, ` text-decoration="%s"`, deco)
+	}
+	return b.String()
+}
+
+// fmtPx formats a pixel value to two decimals without trailing zeros.
+func fmtPx(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
@@ -0,0 +1,174 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"claude-monitor/internal/app"
+	"claude-monitor/internal/consts"
+	"claude-monitor/internal/report"
+	"claude-monitor/internal/store"
+)
+
+// Server polls usage in the background and serves the latest result over
+// HTTP as JSON (/usage), Prometheus text (/metrics), and a health probe
+// (/healthz).
+type Server struct {
+	cfg app.Config
+
+	mu       sync.RWMutex
+	sample   *store.Sample
+	err      error
+	failures int
+	next     time.Time
+}
+
+// usageBody is the /usage response.
+type usageBody struct {
+	*store.Sample
+	Error    string     `json:"error,omitempty"`
+	Failures int        `json:"consecutive_failures"`
+	NextPoll *time.Time `json:"next_poll,omitempty"`
+}
+
+// New builds a server for cfg, seeded with the cached snapshot when present.
+//
+// Parameters:
+//   - cfg: validated Config.
+//
+// Returns:
+//   - server ready for Handler or Run.
+func New(cfg app.Config) *Server {
+	s := &Server{cfg: cfg}
+	if cfg.Snapshot != nil {
+		if snap, err := cfg.Snapshot.Load(); err == nil {
+			s.sample = &snap
+		}
+	}
+	return s
+}
+
+// Run polls usage and serves HTTP on addr until ctx is canceled.
+//
+// Parameters:
+//   - ctx: stops polling and shuts the listener down.
+//   - addr: listen address, e.g. ":9187".
+//   - log: receives the listening message; nil discards it.
+//
+// Returns:
+//   - listener errors; nil after a clean shutdown.
+func (s *Server) Run(ctx context.Context, addr string, log io.Writer) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	if log != nil {
+		fmt.Fprintf(log, consts.TextServeListeningFmt+"\n", ln.Addr())
+	}
+	srv := &http.Server{Handler: s.Handler(), ReadHeaderTimeout: 5 * time.Second}
+
+	go app.Poll(ctx, s.cfg, s.record)
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	if err := srv.Serve(ln); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// record stores a poll result.
+func (s *Server) record(res app.PollResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = res.Err
+	s.failures = res.Failures
+	s.next = res.Time.Add(res.Next)
+	if res.Err == nil {
+		s.sample = &store.Sample{Time: res.Time, UsageResponse: res.Usage}
+	}
+}
+
+// Handler returns the HTTP routes.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/usage", s.handleUsage)
+	mux.HandleFunc("/metrics", s.handleMetrics)
+	mux.HandleFunc("/healthz", s.handleHealth)
+	return mux
+}
+
+// handleUsage serves the latest sample with poll status as JSON; it answers
+// 503 until a first sample exists.
+func (s *Server) handleUsage(w http.ResponseWriter, _ *http.Request) {
+	s.mu.RLock()
+	body := usageBody{Sample: s.sample, Failures: s.failures}
+	if s.err != nil {
+		body.Error = s.err.Error()
+	}
+	if !s.next.IsZero() {
+		next := s.next
+		body.NextPoll = &next
+	}
+	s.mu.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if body.Sample == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// handleMetrics serves Prometheus text exposition.
+func (s *Server) handleMetrics(w http.ResponseWriter, _ *http.Request) {
+	s.mu.RLock()
+	sample, failures := s.sample, s.failures
+	s.mu.RUnlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	if sample != nil {
+		fmt.Fprintln(w, "# HELP claude_usage_utilization_percent Utilization of the rolling usage window.")
+		fmt.Fprintln(w, "# TYPE claude_usage_utilization_percent gauge")
+		for _, win := range report.Windows {
+			if u := win.Pick(sample.UsageResponse); u != nil && u.Utilization != nil {
+				fmt.Fprintf(w, "claude_usage_utilization_percent{window=%q} %g\n", win.Key, *u.Utilization)
+			}
+		}
+		fmt.Fprintln(w, "# HELP claude_usage_resets_at_timestamp_seconds When the usage window resets.")
+		fmt.Fprintln(w, "# TYPE claude_usage_resets_at_timestamp_seconds gauge")
+		for _, win := range report.Windows {
+			if u := win.Pick(sample.UsageResponse); u != nil && u.ResetsAt != nil {
+				fmt.Fprintf(w, "claude_usage_resets_at_timestamp_seconds{window=%q} %d\n", win.Key, u.ResetsAt.Unix())
+			}
+		}
+		fmt.Fprintln(w, "# HELP claude_usage_last_success_timestamp_seconds Time of the last successful fetch.")
+		fmt.Fprintln(w, "# TYPE claude_usage_last_success_timestamp_seconds gauge")
+		fmt.Fprintf(w, "claude_usage_last_success_timestamp_seconds %d\n", sample.Time.Unix())
+	}
+	fmt.Fprintln(w, "# HELP claude_usage_consecutive_failures Failed fetches since the last success.")
+	fmt.Fprintln(w, "# TYPE claude_usage_consecutive_failures gauge")
+	fmt.Fprintf(w, "claude_usage_consecutive_failures %d\n", failures)
+}
+
+// handleHealth answers 200 while the latest poll succeeded and 503 otherwise.
+func (s *Server) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	s.mu.RLock()
+	healthy := s.sample != nil && s.err == nil
+	s.mu.RUnlock()
+	if !healthy {
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+		return
+	}
+	io.WriteString(w, "ok\n")
+}
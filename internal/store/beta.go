@@ -0,0 +1,80 @@
+package store
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+// BetaFile remembers the anthropic-beta header value that last worked, so a
+// fallback discovered once is tried first on every later request and run.
+// It caches the value in memory and is safe for concurrent use.
+type BetaFile struct {
+	path string
+
+	mu     sync.Mutex
+	loaded bool
+	value  string
+}
+
+// betaState is the on-disk shape of a BetaFile.
+type betaState struct {
+	Value string    `json:"value"`
+	Time  time.Time `json:"time"`
+}
+
+// NewBetaFile returns a beta header store backed by the file at path.
+//
+// Parameters:
+//   - path: location of the JSON state file.
+//
+// Returns:
+//   - beta file bound to path.
+func NewBetaFile(path string) *BetaFile {
+	return &BetaFile{path: path}
+}
+
+// Path reports the file backing the store.
+func (f *BetaFile) Path() string {
+	return f.path
+}
+
+// Load returns the remembered header value, reading the file on first use.
+// A missing or malformed file yields an empty value.
+func (f *BetaFile) Load() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.loaded {
+		f.loaded = true
+		if content, err := os.ReadFile(f.path); err == nil {
+			var st betaState
+			if json.Unmarshal(content, &st) == nil {
+				f.value = st.Value
+			}
+		}
+	}
+	return f.value
+}
+
+// Save remembers value in memory and atomically rewrites the file; it is a
+// no-op when value is already remembered.
+//
+// Parameters:
+//   - value: header value that produced a successful response.
+//
+// Returns:
+//   - error when the file cannot be written.
+func (f *BetaFile) Save(value string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.loaded && f.value == value {
+		return nil
+	}
+	f.loaded, f.value = true, value
+	data, err := json.Marshal(betaState{Value: value, Time: time.Now()})
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(f.path, data)
+}
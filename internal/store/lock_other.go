@@ -0,0 +1,11 @@
+//go:build !unix
+
+package store
+
+import "os"
+
+// lockFile is a no-op where flock is unavailable; SampleLog's mutex still
+// serializes access within one process.
+func lockFile(f *os.File, exclusive bool) error {
+	return nil
+}
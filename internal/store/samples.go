@@ -0,0 +1,240 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"claude-monitor/internal/api"
+	"claude-monitor/internal/consts"
+)
+
+// errUnchanged aborts a Prune rewrite that would drop nothing.
+var errUnchanged = errors.New("unchanged")
+
+// Sample is a single usage response recorded at the time it was fetched.
+type Sample struct {
+	Time time.Time `json:"time"`
+	api.UsageResponse
+}
+
+// SampleLog appends samples to a JSON Lines file and reads them back.
+type SampleLog struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewSampleLog returns a log backed by the file at path. The file and its
+// parent directory are created lazily on the first Append.
+//
+// Parameters:
+//   - path: location of the JSON Lines file.
+//
+// Returns:
+//   - sample log bound to path.
+func NewSampleLog(path string) *SampleLog {
+	return &SampleLog{path: path}
+}
+
+// Path reports the file backing the log.
+func (l *SampleLog) Path() string {
+	return l.path
+}
+
+// lock takes the cross-process lock guarding the log, shared for readers
+// and exclusive for writers, so a Prune in one process never drops samples
+// another process appends meanwhile. Readers never create anything: a
+// missing lock file means no locking writer has touched the log, so they
+// read it unlocked, which keeps read-only history directories readable.
+//
+// Returns:
+//   - function releasing the lock.
+//   - error when the lock file cannot be created or locked.
+func (l *SampleLog) lock(exclusive bool) (func(), error) {
+	var f *os.File
+	var err error
+	if exclusive {
+		if err := os.MkdirAll(filepath.Dir(l.path), 0o700); err != nil {
+			return nil, err
+		}
+		f, err = os.OpenFile(l.path+consts.LockFileSuffix, os.O_CREATE|os.O_RDWR, 0o600)
+	} else {
+		f, err = os.Open(l.path + consts.LockFileSuffix)
+		if errors.Is(err, fs.ErrNotExist) {
+			return func() {}, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := lockFile(f, exclusive); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return func() { f.Close() }, nil
+}
+
+// Append writes s as a single JSON line.
+//
+// Parameters:
+//   - s: sample to persist.
+//
+// Returns:
+//   - error when the directory or file cannot be written.
+func (l *SampleLog) Append(s Sample) error {
+	line, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	unlock, err := l.lock(true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(line); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Read returns samples recorded within [since, until], oldest first. A zero
+// since or until leaves that side of the range open. The file is streamed,
+// so only matching samples are held in memory. Malformed lines are skipped
+// so a truncated write never makes the whole log unreadable.
+//
+// Parameters:
+//   - since: inclusive lower bound.
+//   - until: inclusive upper bound.
+//
+// Returns:
+//   - matching samples sorted by time (empty when the log does not exist).
+//   - error when the file exists but cannot be read.
+func (l *SampleLog) Read(since, until time.Time) ([]Sample, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := os.Stat(l.path); errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	unlock, err := l.lock(false)
+	if err != nil {
+		return nil, fmt.Errorf(consts.ErrReadSamplesFmt, err)
+	}
+	defer unlock()
+
+	var samples []Sample
+	err = l.scan(func(s Sample, _ []byte) error {
+		if !since.IsZero() && s.Time.Before(since) {
+			return nil
+		}
+		if !until.IsZero() && s.Time.After(until) {
+			return nil
+		}
+		samples = append(samples, s)
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf(consts.ErrReadSamplesFmt, err)
+	}
+
+	sort.SliceStable(samples, func(i, j int) bool { return samples[i].Time.Before(samples[j].Time) })
+	return samples, nil
+}
+
+// scan calls fn with every well-formed sample in the log and its raw line.
+// The caller holds the lock.
+func (l *SampleLog) scan(fn func(s Sample, line []byte) error) error {
+	f, err := os.Open(l.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64<<10), 1<<20)
+	for sc.Scan() {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var s Sample
+		if err := json.Unmarshal(line, &s); err != nil || s.Time.IsZero() {
+			continue
+		}
+		if err := fn(s, line); err != nil {
+			return err
+		}
+	}
+	return sc.Err()
+}
+
+// Prune rewrites the log keeping only samples recorded at or after cutoff.
+// The exclusive lock is held from the read through the rename, so appends
+// from other processes wait instead of landing in the replaced file.
+//
+// Parameters:
+//   - cutoff: oldest timestamp to keep.
+//
+// Returns:
+//   - error when the log cannot be read or rewritten.
+func (l *SampleLog) Prune(cutoff time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := os.Stat(l.path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	unlock, err := l.lock(true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	dropped := false
+	err = writeFileAtomicFunc(l.path, func(w io.Writer) error {
+		bw := bufio.NewWriter(w)
+		err := l.scan(func(s Sample, line []byte) error {
+			if s.Time.Before(cutoff) {
+				dropped = true
+				return nil
+			}
+			if _, err := bw.Write(line); err != nil {
+				return err
+			}
+			return bw.WriteByte('\n')
+		})
+		if err != nil {
+			return err
+		}
+		if !dropped {
+			return errUnchanged
+		}
+		return bw.Flush()
+	})
+	if errors.Is(err, errUnchanged) || errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
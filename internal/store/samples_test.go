@@ -0,0 +1,116 @@
+package store
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"claude-monitor/internal/consts"
+)
+
+func TestPruneDropsOldSamples(t *testing.T) {
+	log := NewSampleLog(filepath.Join(t.TempDir(), "samples.jsonl"))
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		if err := log.Append(Sample{Time: base.Add(time.Duration(i) * time.Hour)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := log.Prune(base.Add(2 * time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	got, err := log.Read(time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || !got[0].Time.Equal(base.Add(2*time.Hour)) {
+		t.Fatalf("kept %d samples starting %v, want 3 starting at hour 2", len(got), got)
+	}
+}
+
+func TestPruneKeepsConcurrentAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "samples.jsonl")
+	// Separate logs on one path stand in for separate processes: they share
+	// only the file lock, not the mutex.
+	writer, pruner := NewSampleLog(path), NewSampleLog(path)
+	base := time.Now()
+	const appends = 200
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < appends; i++ {
+			if err := writer.Append(Sample{Time: base.Add(time.Duration(i) * time.Second)}); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	for i := 0; i < 50; i++ {
+		if err := pruner.Prune(base.Add(-time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wg.Wait()
+
+	got, err := pruner.Read(time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != appends {
+		t.Fatalf("read %d samples after concurrent prunes, want %d", len(got), appends)
+	}
+}
+
+func TestReadFiltersRange(t *testing.T) {
+	log := NewSampleLog(filepath.Join(t.TempDir(), "samples.jsonl"))
+	if got, err := log.Read(time.Time{}, time.Time{}); err != nil || got != nil {
+		t.Fatalf("missing log: got %v, %v", got, err)
+	}
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, h := range []int{3, 1, 2} {
+		if err := log.Append(Sample{Time: base.Add(time.Duration(h) * time.Hour)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := log.Read(base.Add(2*time.Hour), base.Add(3*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || !got[0].Time.Before(got[1].Time) {
+		t.Fatalf("got %v, want hours 2 and 3 in order", got)
+	}
+}
+
+func TestReadCreatesNothing(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "history")
+	log := NewSampleLog(filepath.Join(dir, "samples.jsonl"))
+	if got, err := log.Read(time.Time{}, time.Time{}); err != nil || got != nil {
+		t.Fatalf("missing log: got %v, %v", got, err)
+	}
+	if _, err := os.Stat(dir); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("Read created the history directory: %v", err)
+	}
+
+	// A log written without the lock file, as an older version or a copied
+	// history directory leaves it, is read as is.
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	line := `{"time":"2025-01-01T00:00:00Z"}` + "\n"
+	if err := os.WriteFile(log.Path(), []byte(line), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := log.Read(time.Time{}, time.Time{}); err != nil || len(got) != 1 {
+		t.Fatalf("unlocked log: got %v, %v; want one sample", got, err)
+	}
+	if _, err := os.Stat(log.Path() + consts.LockFileSuffix); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("Read created the lock file: %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"claude-monitor/internal/consts"
+)
+
+// SnapshotFile persists the most recent successful sample so it can be shown
+// before (or instead of) a live fetch.
+type SnapshotFile struct {
+	path string
+}
+
+// NewSnapshotFile returns a snapshot store backed by the file at path.
+//
+// Parameters:
+//   - path: location of the JSON snapshot.
+//
+// Returns:
+//   - snapshot file bound to path.
+func NewSnapshotFile(path string) *SnapshotFile {
+	return &SnapshotFile{path: path}
+}
+
+// Path reports the file backing the snapshot.
+func (f *SnapshotFile) Path() string {
+	return f.path
+}
+
+// Load reads the last saved sample.
+//
+// Returns:
+//   - the saved sample.
+//   - error when the file is missing, unreadable, or malformed (use
+//     errors.Is(err, fs.ErrNotExist) to detect a cold start).
+func (f *SnapshotFile) Load() (Sample, error) {
+	content, err := os.ReadFile(f.path)
+	if err != nil {
+		return Sample{}, err
+	}
+	var s Sample
+	if err := json.Unmarshal(content, &s); err != nil {
+		return Sample{}, fmt.Errorf(consts.ErrParseSnapshotFmt, fmt.Errorf("%s: %w", f.path, err))
+	}
+	return s, nil
+}
+
+// Save atomically replaces the snapshot with s.
+//
+// Parameters:
+//   - s: sample to persist.
+//
+// Returns:
+//   - error when the snapshot cannot be written.
+func (f *SnapshotFile) Save(s Sample) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(f.path, data)
+}
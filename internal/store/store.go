@@ -0,0 +1,74 @@
+package store
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"claude-monitor/internal/consts"
+)
+
+// DefaultDir returns the per-user directory where local state is kept,
+// falling back to a relative directory when no cache dir can be determined.
+//
+// Returns:
+//   - directory path for samples and other persisted state.
+func DefaultDir() string {
+	if dir, err := os.UserCacheDir(); err == nil {
+		return filepath.Join(dir, consts.AppDirName)
+	}
+	return "." + consts.AppDirName
+}
+
+// DefaultSamplesPath returns the default location of the sample log.
+func DefaultSamplesPath() string {
+	return filepath.Join(DefaultDir(), consts.SamplesFileName)
+}
+
+// DefaultSnapshotPath returns the default location of the last-good snapshot.
+func DefaultSnapshotPath() string {
+	return filepath.Join(DefaultDir(), consts.SnapshotFileName)
+}
+
+// writeFileAtomic replaces path with data by writing a sibling temp file and
+// renaming it into place, so readers never observe a partial file.
+func writeFileAtomic(path string, data []byte) error {
+	return writeFileAtomicFunc(path, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
+
+// writeFileAtomicFunc is writeFileAtomic with the content streamed by write.
+// When write fails the temp file is removed and path is left untouched.
+func writeFileAtomicFunc(path string, write func(w io.Writer) error) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+	if err := write(tmp); err != nil {
+		tmp.Close()
+		os.Remove(tmpName)
+		return err
+	}
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		os.Remove(tmpName)
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	return os.Rename(tmpName, path)
+}
+
+// DefaultBetaPath returns the default location of the remembered beta header.
+func DefaultBetaPath() string {
+	return filepath.Join(DefaultDir(), consts.BetaFileName)
+}
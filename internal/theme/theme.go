@@ -0,0 +1,377 @@
+package theme
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"claude-monitor/internal/consts"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Theme is a complete palette plus the border shape used for boxes.
+type Theme struct {
+	// Name identifies the theme for -theme and the cycle key.
+	Name string
+	// Muted is the subdued text color for labels and metadata.
+	Muted lipgloss.AdaptiveColor
+	// Track fills the empty portion of progress bars.
+	Track lipgloss.AdaptiveColor
+	// Accent is the primary accent for headers and filled bars.
+	Accent lipgloss.AdaptiveColor
+	// AccentHi highlights hot accents such as keybinds.
+	AccentHi lipgloss.AdaptiveColor
+	// Error tints error borders and text.
+	Error lipgloss.AdaptiveColor
+	// Text is the foreground of labels and values.
+	Text lipgloss.AdaptiveColor
+	// OnAccent is the foreground on colored backgrounds such as the header
+	// and filled bars.
+	OnAccent lipgloss.AdaptiveColor
+	// OK, Warn and Crit color bars and values by severity band.
+	OK   lipgloss.AdaptiveColor
+	Warn lipgloss.AdaptiveColor
+	Crit lipgloss.AdaptiveColor
+	// Border is one of consts.Border* and shapes the chart and error boxes.
+	Border string
+}
+
+// BorderStyle returns the lipgloss border for t.Border, rounded when unset
+// or unknown.
+func (t Theme) BorderStyle() lipgloss.Border {
+	switch t.Border {
+	case consts.BorderNormal:
+		return lipgloss.NormalBorder()
+	case consts.BorderThick:
+		return lipgloss.ThickBorder()
+	case consts.BorderDouble:
+		return lipgloss.DoubleBorder()
+	case consts.BorderBlock:
+		return lipgloss.BlockBorder()
+	case consts.BorderHidden:
+		return lipgloss.HiddenBorder()
+	}
+	return lipgloss.RoundedBorder()
+}
+
+// both uses one color on light and dark backgrounds.
+func both(hex string) lipgloss.AdaptiveColor {
+	return lipgloss.AdaptiveColor{Light: hex, Dark: hex}
+}
+
+// builtins are the themes shipped with the binary, in cycle order.
+var builtins = []Theme{
+	{
+		Name:     consts.ThemeDark,
+		Muted:    consts.ColorMuted,
+		Track:    consts.ColorTrack,
+		Accent:   consts.ColorAccent,
+		AccentHi: consts.ColorAccentHi,
+		Error:    consts.ColorError,
+		Text:     consts.ColorWhite,
+		OnAccent: consts.ColorWhite,
+		OK:       lipgloss.AdaptiveColor{Light: "#3f9142", Dark: "#6cbf6c"},
+		Warn:     lipgloss.AdaptiveColor{Light: "#b7791f", Dark: "#e5a50a"},
+		Crit:     consts.ColorError,
+		Border:   consts.BorderRounded,
+	},
+	{
+		Name:     consts.ThemeLight,
+		Muted:    both("#7a6a62"),
+		Track:    both("#d9d4d0"),
+		Accent:   both("#b8532f"),
+		AccentHi: both("#8f3d20"),
+		Error:    both("#b42318"),
+		Text:     both("#2b2b2b"),
+		OnAccent: both("#ffffff"),
+		OK:       both("#2e7d32"),
+		Warn:     both("#b7791f"),
+		Crit:     both("#b42318"),
+		Border:   consts.BorderRounded,
+	},
+	{
+		Name:     consts.ThemeSolarized,
+		Muted:    lipgloss.AdaptiveColor{Light: "#657b83", Dark: "#93a1a1"},
+		Track:    lipgloss.AdaptiveColor{Light: "#eee8d5", Dark: "#073642"},
+		Accent:   both("#268bd2"),
+		AccentHi: both("#b58900"),
+		Error:    both("#dc322f"),
+		Text:     lipgloss.AdaptiveColor{Light: "#073642", Dark: "#eee8d5"},
+		OnAccent: both("#fdf6e3"),
+		OK:       both("#859900"),
+		Warn:     both("#b58900"),
+		Crit:     both("#dc322f"),
+		Border:   consts.BorderNormal,
+	},
+	{
+		// Okabe–Ito colors stay distinct under the common color vision
+		// deficiencies; errors are vermillion rather than red.
+		Name:     consts.ThemeColorblind,
+		Muted:    lipgloss.AdaptiveColor{Light: "#5f5f5f", Dark: "#a8a8a8"},
+		Track:    lipgloss.AdaptiveColor{Light: "#d0d0d0", Dark: "#3a3a3a"},
+		Accent:   both("#0072b2"),
+		AccentHi: both("#e69f00"),
+		Error:    both("#d55e00"),
+		Text:     lipgloss.AdaptiveColor{Light: "#1a1a1a", Dark: "#ffffff"},
+		OnAccent: both("#ffffff"),
+		OK:       both("#009e73"),
+		Warn:     both("#e69f00"),
+		Crit:     both("#d55e00"),
+		Border:   consts.BorderRounded,
+	},
+	{
+		Name:     consts.ThemeHighContrast,
+		Muted:    lipgloss.AdaptiveColor{Light: "#8a8f98", Dark: "#c3c7cf"},
+		Track:    consts.ColorTrack,
+		Accent:   lipgloss.AdaptiveColor{Light: "#ff6b3d", Dark: "#ff8a50"},
+		AccentHi: lipgloss.AdaptiveColor{Light: "#ffd7c2", Dark: "#ffe1cf"},
+		Error:    lipgloss.AdaptiveColor{Light: "#ff4d4f", Dark: "#ff7b84"},
+		Text:     consts.ColorWhite,
+		OnAccent: consts.ColorWhite,
+		OK:       lipgloss.AdaptiveColor{Light: "#1f9e3a", Dark: "#5dff7a"},
+		Warn:     lipgloss.AdaptiveColor{Light: "#c98a00", Dark: "#ffd000"},
+		Crit:     lipgloss.AdaptiveColor{Light: "#ff4d4f", Dark: "#ff7b84"},
+		Border:   consts.BorderRounded,
+	},
+}
+
+// Builtins returns the shipped themes in cycle order; the first is the
+// default.
+func Builtins() []Theme {
+	return append([]Theme(nil), builtins...)
+}
+
+// Default returns the theme used when nothing is selected.
+func Default() Theme {
+	return builtins[0]
+}
+
+// DefaultDir returns the directory scanned for user theme files.
+func DefaultDir() string {
+	if dir, err := os.UserConfigDir(); err == nil {
+		return filepath.Join(dir, consts.AppDirName, consts.ThemeDirName)
+	}
+	return filepath.Join("."+consts.AppDirName, consts.ThemeDirName)
+}
+
+// fileColor accepts either "#rrggbb" for both backgrounds or an object with
+// separate light and dark values.
+type fileColor lipgloss.AdaptiveColor
+
+// UnmarshalJSON decodes a color string or {"light": ..., "dark": ...}.
+func (c *fileColor) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*c = fileColor{Light: s, Dark: s}
+		return nil
+	}
+	var pair struct {
+		Light string `json:"light"`
+		Dark  string `json:"dark"`
+	}
+	if err := json.Unmarshal(b, &pair); err != nil {
+		return errors.New(consts.ErrThemeColorShape)
+	}
+	*c = fileColor(pair)
+	return nil
+}
+
+// file is the on-disk theme format.
+type file struct {
+	Name   string                `json:"name"`
+	Border string                `json:"border"`
+	Colors map[string]*fileColor `json:"colors"`
+}
+
+// paletteKeys are the color entries every theme file must define.
+var paletteKeys = []string{"muted", "track", "accent", "accent_hi", "error", "text", "on_accent"}
+
+// severityKeys are optional entries; a missing one falls back to the
+// palette entry at the same index (accent, accent_hi, error).
+var severityKeys = []string{"ok", "warn", "crit"}
+
+// colorPattern matches hex colors and ANSI palette indexes.
+var colorPattern = regexp.MustCompile(`^(#[0-9a-fA-F]{3}|#[0-9a-fA-F]{6}|[0-9]{1,3})$`)
+
+// Load reads a theme file. Every palette entry must be present; the name
+// defaults to the file name without its extension.
+//
+// Parameters:
+//   - path: JSON theme file.
+//
+// Returns:
+//   - the parsed theme.
+//   - error naming the file and the first problem found.
+func Load(path string) (Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Theme{}, err
+	}
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return Theme{}, fmt.Errorf(consts.ErrThemeFileFmt, path, err)
+	}
+	t := Theme{Name: strings.TrimSpace(f.Name), Border: strings.TrimSpace(f.Border)}
+	if t.Name == "" {
+		t.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	if t.Border == "" {
+		t.Border = consts.BorderRounded
+	}
+	if !validBorder(t.Border) {
+		return Theme{}, fmt.Errorf(consts.ErrThemeFileFmt, path, fmt.Errorf(consts.ErrThemeBorderFmt, t.Border))
+	}
+	slots := []*lipgloss.AdaptiveColor{&t.Muted, &t.Track, &t.Accent, &t.AccentHi, &t.Error, &t.Text, &t.OnAccent}
+	for i, key := range paletteKeys {
+		c := f.Colors[key]
+		if c == nil {
+			return Theme{}, fmt.Errorf(consts.ErrThemeFileFmt, path, fmt.Errorf(consts.ErrThemeMissingFmt, key))
+		}
+		if err := c.validate(key); err != nil {
+			return Theme{}, fmt.Errorf(consts.ErrThemeFileFmt, path, err)
+		}
+		*slots[i] = lipgloss.AdaptiveColor(*c)
+	}
+	severity := []*lipgloss.AdaptiveColor{&t.OK, &t.Warn, &t.Crit}
+	fallback := []lipgloss.AdaptiveColor{t.Accent, t.AccentHi, t.Error}
+	for i, key := range severityKeys {
+		c := f.Colors[key]
+		if c == nil {
+			*severity[i] = fallback[i]
+			continue
+		}
+		if err := c.validate(key); err != nil {
+			return Theme{}, fmt.Errorf(consts.ErrThemeFileFmt, path, err)
+		}
+		*severity[i] = lipgloss.AdaptiveColor(*c)
+	}
+	return t, nil
+}
+
+// validate checks both values of the entry called key.
+func (c fileColor) validate(key string) error {
+	for _, v := range []string{c.Light, c.Dark} {
+		if !validColor(v) {
+			return fmt.Errorf(consts.ErrThemeColorFmt, key, v)
+		}
+	}
+	return nil
+}
+
+// validColor reports whether v is a hex color or a 0–255 palette index.
+func validColor(v string) bool {
+	if !colorPattern.MatchString(v) {
+		return false
+	}
+	if v[0] == '#' {
+		return true
+	}
+	n, err := strconv.Atoi(v)
+	return err == nil && n <= 255
+}
+
+// validBorder reports whether name is a known border style.
+func validBorder(name string) bool {
+	switch name {
+	case consts.BorderRounded, consts.BorderNormal, consts.BorderThick,
+		consts.BorderDouble, consts.BorderBlock, consts.BorderHidden:
+		return true
+	}
+	return false
+}
+
+// LoadDir reads every *.json theme in dir, sorted by file name. A missing
+// directory yields no themes.
+//
+// Parameters:
+//   - dir: theme directory; empty disables user themes.
+//
+// Returns:
+//   - the themes found.
+//   - the first file error.
+func LoadDir(dir string) ([]Theme, error) {
+	if strings.TrimSpace(dir) == "" {
+		return nil, nil
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	themes := make([]Theme, 0, len(paths))
+	for _, p := range paths {
+		t, err := Load(p)
+		if err != nil {
+			return nil, err
+		}
+		themes = append(themes, t)
+	}
+	return themes, nil
+}
+
+// Select builds the cycle list: built-ins, then the themes in dir (a user
+// theme replaces a built-in of the same name), rotated so the selected one
+// comes first.
+//
+// Parameters:
+//   - name: theme name, or a path to a theme file; empty selects the default.
+//   - dir: user theme directory; empty disables it.
+//
+// Returns:
+//   - themes in cycle order starting with the selection.
+//   - error when a theme file is invalid or the name is unknown.
+func Select(name, dir string) ([]Theme, error) {
+	user, err := LoadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	themes := Builtins()
+	for _, u := range user {
+		if i := indexOf(themes, u.Name); i >= 0 {
+			themes[i] = u
+		} else {
+			themes = append(themes, u)
+		}
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return themes, nil
+	}
+	if strings.ContainsAny(name, `/\`) || strings.HasSuffix(name, ".json") {
+		t, err := Load(name)
+		if err != nil {
+			return nil, err
+		}
+		if i := indexOf(themes, t.Name); i >= 0 {
+			themes = append(themes[:i], themes[i+1:]...)
+		}
+		return append([]Theme{t}, themes...), nil
+	}
+	i := indexOf(themes, name)
+	if i < 0 {
+		names := make([]string, len(themes))
+		for k, t := range themes {
+			names[k] = t.Name
+		}
+		return nil, fmt.Errorf(consts.ErrThemeUnknownFmt, name, strings.Join(names, ", "))
+	}
+	return append(append([]Theme{}, themes[i:]...), themes[:i]...), nil
+}
+
+// indexOf returns the position of the theme called name, or -1.
+func indexOf(themes []Theme, name string) int {
+	for i, t := range themes {
+		if strings.EqualFold(t.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
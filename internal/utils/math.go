@@ -33,3 +33,18 @@ func Max(a, b int) int {
 	}
 	return b
 }
+
+// Min returns the smaller of a and b.
+//
+// Parameters:
+//   - a: first integer.
+//   - b: second integer.
+//
+// Returns:
+//   - the lesser of a or b.
+func Min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
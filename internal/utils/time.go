@@ -2,6 +2,8 @@ package utils
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"claude-monitor/internal/consts"
@@ -15,7 +17,19 @@ import (
 // Returns:
 //   - human-friendly "updated X ago" text.
 func HumanTime(t time.Time) string {
-	diff := time.Since(t)
+	return HumanTimeAt(t, time.Now())
+}
+
+// HumanTimeAt is HumanTime measured against a fixed now.
+//
+// Parameters:
+//   - t: timestamp to describe.
+//   - now: reference time.
+//
+// Returns:
+//   - human-friendly "updated X ago" text.
+func HumanTimeAt(t, now time.Time) string {
+	diff := now.Sub(t)
 	switch {
 	case diff < 5*time.Second:
 		return consts.TextUpdatedNow
@@ -64,6 +78,76 @@ func FriendlyDuration(d time.Duration) string {
 	}
 }
 
+// ResetFormat controls how reset times are shown.
+type ResetFormat struct {
+	// Clock is consts.Clock24h or consts.Clock12h.
+	Clock string
+	// Location is the zone reset times are shown in; nil means time.Local.
+	Location *time.Location
+	// UTC appends the UTC time to each reset time.
+	UTC bool
+	// Show is consts.ResetTimeBoth, ResetTimeAbsolute or ResetTimeRelative.
+	Show string
+}
+
+// resetFormat is the format FormatReset uses; SetResetFormat changes it.
+var resetFormat = ResetFormat{Clock: consts.Clock24h, Show: consts.ResetTimeBoth}
+
+// ParseResetFormat validates the reset time flags.
+//
+// Parameters:
+//   - clock: consts.Clock24h or consts.Clock12h.
+//   - tz: IANA zone name, or empty for the local zone.
+//   - utc: whether to add the UTC time.
+//   - show: consts.ResetTimeBoth, ResetTimeAbsolute or ResetTimeRelative.
+//
+// Returns:
+//   - the parsed format.
+//   - error for an unknown clock, zone or show value.
+func ParseResetFormat(clock, tz string, utc bool, show string) (ResetFormat, error) {
+	f := ResetFormat{Clock: strings.ToLower(strings.TrimSpace(clock)), UTC: utc, Show: strings.ToLower(strings.TrimSpace(show))}
+	switch f.Clock {
+	case consts.Clock24h, consts.Clock12h:
+	default:
+		return ResetFormat{}, fmt.Errorf(consts.ErrClockFmt, clock)
+	}
+	switch f.Show {
+	case consts.ResetTimeBoth, consts.ResetTimeAbsolute, consts.ResetTimeRelative:
+	default:
+		return ResetFormat{}, fmt.Errorf(consts.ErrResetTimeFmt, show)
+	}
+	if tz = strings.TrimSpace(tz); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return ResetFormat{}, fmt.Errorf(consts.ErrTZFmt, tz, err)
+		}
+		f.Location = loc
+	}
+	return f, nil
+}
+
+// SetResetFormat changes how FormatReset and FormatResetTime render reset
+// times from now on.
+//
+// Parameters:
+//   - f: format as returned by ParseResetFormat.
+func SetResetFormat(f ResetFormat) {
+	resetFormat = f
+}
+
+// CurrentResetFormat returns the format set by SetResetFormat.
+func CurrentResetFormat() ResetFormat {
+	return resetFormat
+}
+
+// ResetLocation returns the zone reset times are shown in.
+func ResetLocation() *time.Location {
+	if resetFormat.Location == nil {
+		return time.Local
+	}
+	return resetFormat.Location
+}
+
 // FormatReset builds user-facing reset and remaining strings.
 //
 // Parameters:
@@ -72,19 +156,196 @@ func FriendlyDuration(d time.Duration) string {
 // Returns:
 //   - reset string and remaining-duration string (or empty strings when zero time).
 func FormatReset(t time.Time) (string, string) {
+	return FormatResetAt(t, time.Now())
+}
+
+// FormatResetAt is FormatReset with the remaining time measured from a fixed
+// now. Either string is empty when the reset format hides it.
+//
+// Parameters:
+//   - t: reset timestamp.
+//   - now: reference time.
+//
+// Returns:
+//   - reset string and remaining-duration string (or empty strings when zero time).
+func FormatResetAt(t, now time.Time) (string, string) {
 	if t.IsZero() {
 		return "", ""
 	}
 
-	local := t.In(time.Local)
-	reset := fmt.Sprintf(consts.TextResetAtFmt, local.Format(consts.ResetTimeLayout))
+	var reset, remain string
+	if resetFormat.Show != consts.ResetTimeRelative {
+		reset = fmt.Sprintf(consts.TextResetAtFmt, FormatResetTime(t, now))
+	}
+	if resetFormat.Show != consts.ResetTimeAbsolute {
+		remain = FormatRemainAt(t, now)
+	}
+	return reset, remain
+}
+
+// FormatRemainAt describes the time left until t regardless of the reset
+// format, for outputs that always show it.
+//
+// Parameters:
+//   - t: reset timestamp.
+//   - now: reference time.
+//
+// Returns:
+//   - remaining-duration string, or the "resets soon" text once t passed.
+func FormatRemainAt(t, now time.Time) string {
+	if d := t.Sub(now); d > 0 {
+		return fmt.Sprintf(consts.TextRemainFmt, FriendlyDuration(d))
+	}
+	return consts.TextResetSoon
+}
+
+// FormatResetTime renders t in the reset zone and clock, followed by the UTC
+// time when the format asks for it.
+//
+// Parameters:
+//   - t: reset timestamp.
+//   - now: reference time deciding whether the weekday and date are shown.
+//
+// Returns:
+//   - the clock time.
+func FormatResetTime(t, now time.Time) string {
+	loc := ResetLocation()
+	out := FormatClockIn(t, now, loc)
+	if resetFormat.UTC && !SameZoneAt(loc, time.UTC, t) {
+		out = fmt.Sprintf(consts.TextResetUTCFmt, out, FormatClockIn(t, now, time.UTC))
+	}
+	return out
+}
+
+// SameZoneAt reports whether a and b show t with the same abbreviation and
+// offset, so "Etc/UTC" matches time.UTC even though they are distinct
+// locations.
+func SameZoneAt(a, b *time.Location, t time.Time) bool {
+	an, ao := t.In(a).Zone()
+	bn, bo := t.In(b).Zone()
+	return an == bn && ao == bo
+}
 
-	var remain string
-	if d := time.Until(local); d > 0 {
-		remain = fmt.Sprintf(consts.TextRemainFmt, FriendlyDuration(d))
-	} else {
-		remain = consts.TextResetSoon
+// FormatClockIn renders t in loc with the reset clock, adding the weekday
+// when t falls on another day than now and the date as well when it is more
+// than a day away, so a weekly reset is never mistaken for today's.
+//
+// Parameters:
+//   - t: time to show.
+//   - now: reference time.
+//   - loc: zone to show t in.
+//
+// Returns:
+//   - the clock time.
+func FormatClockIn(t, now time.Time, loc *time.Location) string {
+	t, now = t.In(loc), now.In(loc)
+	twelve := resetFormat.Clock == consts.Clock12h
+	layout := consts.ResetTimeLayout
+	if twelve {
+		layout = consts.ResetTime12Layout
+	}
+	ty, tm, td := t.Date()
+	ny, nm, nd := now.Date()
+	switch d := t.Sub(now); {
+	case ty == ny && tm == nm && td == nd:
+	case d > 0 && d <= 24*time.Hour:
+		layout = consts.ResetDayLayout
+		if twelve {
+			layout = consts.ResetDay12Layout
+		}
+	default:
+		layout = consts.ResetDateLayout
+		if twelve {
+			layout = consts.ResetDate12Layout
+		}
 	}
+	return FormatTime(t, layout)
+}
 
-	return reset, remain
+// FormatTime formats t like time.Format, spelling the "Jan", "Mon" and "PM"
+// layout tokens with consts.ShortMonthNames, consts.ShortWeekdayNames and
+// consts.DayPeriodNames so dates follow the active language.
+//
+// Parameters:
+//   - t: time to format, already in the wanted location.
+//   - layout: Go reference layout.
+//
+// Returns:
+//   - the formatted time.
+func FormatTime(t time.Time, layout string) string {
+	var b strings.Builder
+	for layout != "" {
+		i, n, name := nextNameToken(layout, t)
+		if i < 0 {
+			b.WriteString(t.Format(layout))
+			break
+		}
+		if i > 0 {
+			b.WriteString(t.Format(layout[:i]))
+		}
+		b.WriteString(name)
+		layout = layout[i+n:]
+	}
+	return b.String()
+}
+
+// nextNameToken finds the first short month, weekday or day period token in
+// layout, skipping the long "January" and "Monday" forms.
+//
+// Returns:
+//   - the token offset, or -1 when there is none.
+//   - the token length.
+//   - the localized name t has for the token.
+func nextNameToken(layout string, t time.Time) (int, int, string) {
+	for i := 0; i < len(layout); i++ {
+		switch rest := layout[i:]; {
+		case strings.HasPrefix(rest, "January"):
+			i += len("January") - 1
+		case strings.HasPrefix(rest, "Monday"):
+			i += len("Monday") - 1
+		case strings.HasPrefix(rest, "Jan"):
+			return i, len("Jan"), consts.ShortMonthNames[t.Month()-1]
+		case strings.HasPrefix(rest, "Mon"):
+			return i, len("Mon"), consts.ShortWeekdayNames[t.Weekday()]
+		case strings.HasPrefix(rest, "PM"):
+			return i, len("PM"), consts.DayPeriodNames[t.Hour()/12]
+		}
+	}
+	return -1, 0, ""
+}
+
+// ParseSpan parses a look-back span such as "90m", "24h", "7d" or "2w". Day
+// and week suffixes are accepted in addition to everything
+// time.ParseDuration understands.
+//
+// Parameters:
+//   - s: span text.
+//
+// Returns:
+//   - positive duration.
+//   - error when the text is empty, malformed, or not positive.
+func ParseSpan(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf(consts.ErrSpanInvalidFmt, s)
+	}
+	unit := time.Duration(0)
+	switch {
+	case strings.HasSuffix(s, "d"):
+		unit = 24 * time.Hour
+	case strings.HasSuffix(s, "w"):
+		unit = 7 * 24 * time.Hour
+	}
+	if unit > 0 {
+		n, err := strconv.ParseFloat(strings.TrimSpace(s[:len(s)-1]), 64)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf(consts.ErrSpanInvalidFmt, s)
+		}
+		return time.Duration(n * float64(unit)), nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf(consts.ErrSpanInvalidFmt, s)
+	}
+	return d, nil
 }
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"claude-monitor/internal/consts"
+)
+
+func TestParseResetFormat(t *testing.T) {
+	f, err := ParseResetFormat(" 12H ", "Asia/Tokyo", true, "Absolute")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Clock != consts.Clock12h || f.Show != consts.ResetTimeAbsolute || !f.UTC || f.Location.String() != "Asia/Tokyo" {
+		t.Fatalf("got %+v", f)
+	}
+	if f, err := ParseResetFormat(consts.Clock24h, "", false, consts.ResetTimeBoth); err != nil || f.Location != nil {
+		t.Fatalf("empty zone: got %+v, %v; want the local zone", f, err)
+	}
+	for _, bad := range [][3]string{
+		{"13h", "", consts.ResetTimeBoth},
+		{consts.Clock24h, "Mars/Olympus", consts.ResetTimeBoth},
+		{consts.Clock24h, "", "soon"},
+	} {
+		if _, err := ParseResetFormat(bad[0], bad[1], false, bad[2]); err == nil {
+			t.Errorf("ParseResetFormat(%q, %q, %q) accepted", bad[0], bad[1], bad[2])
+		}
+	}
+}
+
+func TestFormatTimeLocalizesNames(t *testing.T) {
+	months, weekdays, periods := consts.ShortMonthNames, consts.ShortWeekdayNames, consts.DayPeriodNames
+	defer func() {
+		consts.ShortMonthNames, consts.ShortWeekdayNames, consts.DayPeriodNames = months, weekdays, periods
+	}()
+
+	ts := time.Date(2025, time.March, 3, 15, 4, 0, 0, time.UTC)
+	const layout = "Monday Mon Jan 02 3:04 PM January"
+	if got, want := FormatTime(ts, layout), ts.Format(layout); got != want {
+		t.Fatalf("English: got %q, want %q", got, want)
+	}
+
+	consts.ShortMonthNames[time.March-1] = "Mär"
+	consts.ShortWeekdayNames[time.Monday] = "Mo"
+	consts.DayPeriodNames = [2]string{"vorm.", "nachm."}
+	if got, want := FormatTime(ts, layout), "Monday Mo Mär 03 3:04 nachm. March"; got != want {
+		t.Fatalf("localized: got %q, want %q", got, want)
+	}
+}
+
+func TestFormatResetTimeSkipsDuplicateUTC(t *testing.T) {
+	defer SetResetFormat(CurrentResetFormat())
+	reset := time.Date(2025, time.January, 2, 17, 0, 0, 0, time.UTC)
+	now := reset.Add(-2 * time.Hour)
+
+	f, err := ParseResetFormat(consts.Clock24h, "Etc/UTC", true, consts.ResetTimeBoth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetResetFormat(f)
+	if got := FormatResetTime(reset, now); got != "17:00 UTC" {
+		t.Fatalf("Etc/UTC: got %q, want the time once", got)
+	}
+
+	f.Location, _ = time.LoadLocation("Asia/Tokyo")
+	SetResetFormat(f)
+	if got := FormatResetTime(reset, now); got != "02:00 JST (17:00 UTC)" {
+		t.Fatalf("Asia/Tokyo: got %q", got)
+	}
+}